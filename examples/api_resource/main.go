@@ -23,6 +23,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/asgardeo/go/pkg/api_resource"
 	"github.com/asgardeo/go/pkg/config"
 	"github.com/asgardeo/go/pkg/sdk"
 )
@@ -78,4 +79,20 @@ func main() {
 	} else {
 		log.Printf("Found API Resource by identifier: %s\n", apiResourcesByIdentifier.Name)
 	}
+
+	// Expand an API resource collection into its API resources and scopes.
+	collection, err := client.APIResource.ExpandAPIResourceCollection(ctx, "collection_id", api_resource.CollectionAccessWrite)
+	if err != nil {
+		log.Printf("Error expanding API Resource collection: %v", err)
+	} else {
+		log.Printf("Collection %s grants access to %d API Resources.\n", collection.DisplayName, len(collection.APIResources))
+	}
+
+	// Search scopes across all API resources by name.
+	scopes, err := client.APIResource.SearchScopes(ctx, "internal_user")
+	if err != nil {
+		log.Printf("Error searching scopes: %v", err)
+	} else {
+		log.Printf("Found %d scopes.\n", len(*scopes))
+	}
 }
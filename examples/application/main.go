@@ -300,4 +300,12 @@ func main() {
 	} else {
 		log.Printf("Login flow updated successfully.")
 	}
+
+	// Get the current login flow with resolved authenticator names.
+	currentLoginFlow, err := client.Application.GetLoginFlow(ctx, appId)
+	if err != nil {
+		log.Printf("Error getting login flow: %v", err)
+	} else {
+		log.Printf("Current login flow: %s\n", common.ToJSONString(currentLoginFlow.Steps))
+	}
 }
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/asgardeo/go/pkg/config"
+	"github.com/asgardeo/go/pkg/identity_provider"
 	"github.com/asgardeo/go/pkg/sdk"
 )
 
@@ -51,4 +52,33 @@ func main() {
 	} else {
 		log.Printf("Found %d Identity Providers.\n", len(*identityProviders.IdentityProviders))
 	}
+
+	// Get an Identity Provider by ID.
+	identityProvider, err := client.IdentityProvider.Get(ctx, "idp_uuid")
+	if err != nil {
+		log.Printf("Error getting Identity Provider: %v", err)
+	} else {
+		log.Printf("Found Identity Provider: %s\n", *identityProvider.Name)
+	}
+
+	// Delete an Identity Provider. Deletion is refused while applications use it unless forced.
+	err = client.IdentityProvider.Delete(ctx, "idp_uuid", &identity_provider.IdentityProviderDeleteOptionsModel{Force: false})
+	if err != nil {
+		log.Printf("Error deleting Identity Provider: %v", err)
+	} else {
+		log.Printf("Successfully deleted Identity Provider.")
+	}
+
+	// Create a Google connection and add it to the login flow of an application.
+	googleConnection, err := client.IdentityProvider.CreateGoogleConnection(ctx, "Google", "google_client_id", "google_client_secret",
+		&identity_provider.SocialConnectionOptionsModel{
+			AddToLoginFlow: func(ctx context.Context, idpName string, authenticatorId string) error {
+				return client.Application.AddIdentityProviderToLoginFlow(ctx, "app_uuid", idpName, authenticatorId, true)
+			},
+		})
+	if err != nil {
+		log.Printf("Error creating Google connection: %v", err)
+	} else {
+		log.Printf("Created Google connection with ID: %s\n", *googleConnection.Id)
+	}
 }
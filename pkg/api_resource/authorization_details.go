@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/asgardeo/go/pkg/api_resource/internal"
+	"github.com/asgardeo/go/pkg/common"
+)
+
+var jsonSchemaTypes = map[string]struct{}{
+	"null":    {},
+	"boolean": {},
+	"object":  {},
+	"array":   {},
+	"number":  {},
+	"string":  {},
+	"integer": {},
+}
+
+// GetAuthorizationDetailsTypes retrieves the authorization details types registered for an API resource.
+func (c *APIResourceClient) GetAuthorizationDetailsTypes(ctx context.Context, id string) (*[]AuthorizationDetailsTypeResponseModel, error) {
+	resp, err := c.apiResourceClient.GetAuthorizationDetailsTypesWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization details types: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get authorization details types: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetAuthorizationDetailsType retrieves an authorization details type of an API resource by its ID.
+func (c *APIResourceClient) GetAuthorizationDetailsType(ctx context.Context, id string, typeId string) (*AuthorizationDetailsTypeResponseModel, error) {
+	resp, err := c.apiResourceClient.GetAuthorizationDetailsTypeWithResponse(ctx, id, typeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization details type: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get authorization details type: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// AddAuthorizationDetailsTypes registers authorization details types for an API resource.
+// The JSON Schema of each type is validated before the request is sent.
+func (c *APIResourceClient) AddAuthorizationDetailsTypes(ctx context.Context, id string, types []AuthorizationDetailsTypeCreateModel) (*[]AuthorizationDetailsTypeResponseModel, error) {
+	for _, detailsType := range types {
+		if err := ValidateAuthorizationDetailsType(&detailsType); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.apiResourceClient.AddAuthorizationDetailsTypesWithResponse(ctx, id, types)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add authorization details types: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to add authorization details types: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateAuthorizationDetailsType updates an authorization details type of an API resource.
+// The JSON Schema of the type is validated before the request is sent.
+func (c *APIResourceClient) UpdateAuthorizationDetailsType(ctx context.Context, id string, typeId string, detailsType *AuthorizationDetailsTypeCreateModel) error {
+	if err := ValidateAuthorizationDetailsType(detailsType); err != nil {
+		return err
+	}
+
+	resp, err := c.apiResourceClient.UpdateAuthorizationDetailsTypeWithResponse(ctx, id, typeId, *detailsType)
+	if err != nil {
+		return fmt.Errorf("failed to update authorization details type: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update authorization details type: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// DeleteAuthorizationDetailsType deletes an authorization details type of an API resource.
+func (c *APIResourceClient) DeleteAuthorizationDetailsType(ctx context.Context, id string, typeId string) error {
+	resp, err := c.apiResourceClient.DeleteAuthorizationDetailsTypeWithResponse(ctx, id, typeId)
+	if err != nil {
+		return fmt.Errorf("failed to delete authorization details type: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete authorization details type: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// GetAuthorizationDetailsTypesInTenant retrieves the authorization details types of all API resources in the tenant.
+// An empty filter returns every type.
+func (c *APIResourceClient) GetAuthorizationDetailsTypesInTenant(ctx context.Context, filter string) (*[]AuthorizationDetailsTypeResponseModel, error) {
+	params := internal.GetAuthorizationDetailsTypesInTenantParams{}
+	if filter != "" {
+		if _, err := common.ParseFilter(filter); err != nil {
+			return nil, fmt.Errorf("invalid authorization details types filter: %w", err)
+		}
+		params.Filter = &filter
+	}
+	resp, err := c.apiResourceClient.GetAuthorizationDetailsTypesInTenantWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization details types: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get authorization details types: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// IsAuthorizationDetailsTypeExists checks whether an authorization details type is registered
+// by any API resource in the tenant.
+func (c *APIResourceClient) IsAuthorizationDetailsTypeExists(ctx context.Context, detailsType string) (bool, error) {
+	params := internal.IsAuthorizationDetailsTypeExistsParams{
+		Filter: common.Eq("type", detailsType).String(),
+	}
+	resp, err := c.apiResourceClient.IsAuthorizationDetailsTypeExistsWithResponse(ctx, &params)
+	if err != nil {
+		return false, fmt.Errorf("failed to check authorization details type: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check authorization details type: status %d", resp.StatusCode())
+	}
+}
+
+// ValidateAuthorizationDetailsType checks that an authorization details type has a type, a name
+// and a structurally valid JSON Schema.
+func ValidateAuthorizationDetailsType(detailsType *AuthorizationDetailsTypeCreateModel) error {
+	if detailsType == nil {
+		return fmt.Errorf("authorization details type is required")
+	}
+	if detailsType.Type == "" {
+		return fmt.Errorf("authorization details type must have a type")
+	}
+	if detailsType.Name == "" {
+		return fmt.Errorf("authorization details type '%s' must have a name", detailsType.Type)
+	}
+	if len(detailsType.Schema) == 0 {
+		return fmt.Errorf("authorization details type '%s' must have a schema", detailsType.Type)
+	}
+	// Round trip the schema so that values built in Go are checked in their JSON form
+	schemaJSON, err := json.Marshal(detailsType.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema for authorization details type '%s': %w", detailsType.Type, err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return fmt.Errorf("invalid schema for authorization details type '%s': %w", detailsType.Type, err)
+	}
+	if err := validateJSONSchema(schema, "#"); err != nil {
+		return fmt.Errorf("invalid schema for authorization details type '%s': %w", detailsType.Type, err)
+	}
+	return nil
+}
+
+// validateJSONSchema checks the keywords of a JSON Schema document, recursing into subschemas
+func validateJSONSchema(schema map[string]interface{}, path string) error {
+	for _, keyword := range []string{"$schema", "$id", "$ref", "title", "description", "format"} {
+		if value, ok := schema[keyword]; ok {
+			if _, isString := value.(string); !isString {
+				return fmt.Errorf("%s/%s must be a string", path, keyword)
+			}
+		}
+	}
+
+	if value, ok := schema["type"]; ok {
+		if err := validateSchemaType(value, path+"/type"); err != nil {
+			return err
+		}
+	}
+
+	if value, ok := schema["properties"]; ok {
+		properties, isObject := value.(map[string]interface{})
+		if !isObject {
+			return fmt.Errorf("%s/properties must be an object", path)
+		}
+		for name, property := range properties {
+			if err := validateSubschema(property, path+"/properties/"+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if value, ok := schema["required"]; ok {
+		if err := validateUniqueStrings(value, path+"/required"); err != nil {
+			return err
+		}
+	}
+
+	if value, ok := schema["enum"]; ok {
+		values, isArray := value.([]interface{})
+		if !isArray || len(values) == 0 {
+			return fmt.Errorf("%s/enum must be a non-empty array", path)
+		}
+	}
+
+	if value, ok := schema["items"]; ok {
+		if items, isArray := value.([]interface{}); isArray {
+			for i, item := range items {
+				if err := validateSubschema(item, fmt.Sprintf("%s/items/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		} else if err := validateSubschema(value, path+"/items"); err != nil {
+			return err
+		}
+	}
+
+	for _, keyword := range []string{"additionalProperties", "not", "contains", "propertyNames", "if", "then", "else"} {
+		if value, ok := schema[keyword]; ok {
+			if err := validateSubschema(value, path+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		if value, ok := schema[keyword]; ok {
+			subschemas, isArray := value.([]interface{})
+			if !isArray || len(subschemas) == 0 {
+				return fmt.Errorf("%s/%s must be a non-empty array", path, keyword)
+			}
+			for i, subschema := range subschemas {
+				if err := validateSubschema(subschema, fmt.Sprintf("%s/%s/%d", path, keyword, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, keyword := range []string{"$defs", "definitions", "patternProperties"} {
+		if value, ok := schema[keyword]; ok {
+			subschemas, isObject := value.(map[string]interface{})
+			if !isObject {
+				return fmt.Errorf("%s/%s must be an object", path, keyword)
+			}
+			for name, subschema := range subschemas {
+				if keyword == "patternProperties" {
+					if _, err := regexp.Compile(name); err != nil {
+						return fmt.Errorf("%s/%s has an invalid pattern '%s': %w", path, keyword, name, err)
+					}
+				}
+				if err := validateSubschema(subschema, path+"/"+keyword+"/"+name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, keyword := range []string{"minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties"} {
+		if value, ok := schema[keyword]; ok {
+			number, isNumber := value.(float64)
+			if !isNumber || number < 0 || number != float64(int64(number)) {
+				return fmt.Errorf("%s/%s must be a non-negative integer", path, keyword)
+			}
+		}
+	}
+
+	for _, keyword := range []string{"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf"} {
+		if value, ok := schema[keyword]; ok {
+			number, isNumber := value.(float64)
+			if !isNumber || (keyword == "multipleOf" && number <= 0) {
+				return fmt.Errorf("%s/%s must be a number", path, keyword)
+			}
+		}
+	}
+
+	if value, ok := schema["pattern"]; ok {
+		pattern, isString := value.(string)
+		if !isString {
+			return fmt.Errorf("%s/pattern must be a string", path)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%s/pattern is not a valid regular expression: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSubschema validates a value that must be a JSON Schema, which may also be a boolean
+func validateSubschema(value interface{}, path string) error {
+	switch subschema := value.(type) {
+	case bool:
+		return nil
+	case map[string]interface{}:
+		return validateJSONSchema(subschema, path)
+	default:
+		return fmt.Errorf("%s must be a schema object or boolean", path)
+	}
+}
+
+func validateSchemaType(value interface{}, path string) error {
+	switch schemaType := value.(type) {
+	case string:
+		if _, ok := jsonSchemaTypes[schemaType]; !ok {
+			return fmt.Errorf("%s has unknown type '%s'", path, schemaType)
+		}
+		return nil
+	case []interface{}:
+		if len(schemaType) == 0 {
+			return fmt.Errorf("%s must not be empty", path)
+		}
+		if err := validateUniqueStrings(schemaType, path); err != nil {
+			return err
+		}
+		for _, item := range schemaType {
+			if _, ok := jsonSchemaTypes[item.(string)]; !ok {
+				return fmt.Errorf("%s has unknown type '%s'", path, item)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s must be a string or an array of strings", path)
+	}
+}
+
+func validateUniqueStrings(value interface{}, path string) error {
+	items, isArray := value.([]interface{})
+	if !isArray {
+		return fmt.Errorf("%s must be an array of strings", path)
+	}
+	seen := make(map[string]struct{})
+	for _, item := range items {
+		str, isString := item.(string)
+		if !isString {
+			return fmt.Errorf("%s must be an array of strings", path)
+		}
+		if _, duplicate := seen[str]; duplicate {
+			return fmt.Errorf("%s contains duplicate value '%s'", path, str)
+		}
+		seen[str] = struct{}{}
+	}
+	return nil
+}
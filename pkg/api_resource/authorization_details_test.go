@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAuthorizationDetailsType(t *testing.T) {
+	validSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"type", "instructedAmount"},
+		"properties": map[string]interface{}{
+			"type": map[string]interface{}{"type": "string", "enum": []string{"payment_initiation"}},
+			"instructedAmount": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"currency": map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$"},
+					"amount":   map[string]interface{}{"type": "string", "minLength": 1},
+				},
+			},
+		},
+		"additionalProperties": false,
+	}
+
+	tests := []struct {
+		name        string
+		detailsType *AuthorizationDetailsTypeCreateModel
+		expectedErr string
+	}{
+		{
+			name:        "valid schema",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment", Schema: validSchema},
+		},
+		{
+			name:        "missing type",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Name: "Payment", Schema: validSchema},
+			expectedErr: "must have a type",
+		},
+		{
+			name:        "missing schema",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment"},
+			expectedErr: "must have a schema",
+		},
+		{
+			name: "unknown type keyword",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"amount": map[string]interface{}{"type": "decimal"}},
+			}},
+			expectedErr: "#/properties/amount/type has unknown type 'decimal'",
+		},
+		{
+			name: "duplicate required property",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{
+				"required": []string{"amount", "amount"},
+			}},
+			expectedErr: "#/required contains duplicate value 'amount'",
+		},
+		{
+			name: "invalid pattern",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{
+				"type":    "string",
+				"pattern": "[A-Z",
+			}},
+			expectedErr: "#/pattern is not a valid regular expression",
+		},
+		{
+			name: "negative length",
+			detailsType: &AuthorizationDetailsTypeCreateModel{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{
+				"type":      "string",
+				"maxLength": -1,
+			}},
+			expectedErr: "#/maxLength must be a non-negative integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAuthorizationDetailsType(tt.detailsType)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAddAuthorizationDetailsTypes(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PUT /api/server/v1/api-resources/payments-api/authorization-details-types": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"id": "type-1", "type": "payment_initiation", "name": "Payment", "description": ""}]`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	types, err := client.AddAuthorizationDetailsTypes(context.Background(), "payments-api", []AuthorizationDetailsTypeCreateModel{
+		{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{"type": "object"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, types)
+	require.Len(t, *types, 1)
+	assert.Equal(t, "type-1", (*types)[0].Id)
+}
+
+func TestAddAuthorizationDetailsTypesRejectsUndeclaredStatus(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PUT /api/server/v1/api-resources/payments-api/authorization-details-types": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusCreated, `[]`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	types, err := client.AddAuthorizationDetailsTypes(context.Background(), "payments-api", []AuthorizationDetailsTypeCreateModel{
+		{Type: "payment_initiation", Name: "Payment", Schema: map[string]interface{}{"type": "object"}},
+	})
+	require.Error(t, err)
+	assert.Nil(t, types)
+	assert.Contains(t, err.Error(), "status 201")
+}
@@ -83,7 +83,7 @@ func (c *APIResourceClient) Get(ctx context.Context, id string) (*APIResourceInf
 }
 
 func (c *APIResourceClient) GetByName(ctx context.Context, name string) (*[]APIResourceListItemModel, error) {
-	filter := internal.Filter(fmt.Sprintf("name eq %s", name))
+	filter := internal.Filter(common.Eq("name", name).String())
 	params := internal.GetAPIResourcesParams{
 		Filter: &filter,
 	}
@@ -99,7 +99,7 @@ func (c *APIResourceClient) GetByName(ctx context.Context, name string) (*[]APIR
 }
 
 func (c *APIResourceClient) GetByIdentifier(ctx context.Context, identifier string) (*APIResourceListItemModel, error) {
-	filter := internal.Filter(fmt.Sprintf("identifier eq %s", identifier))
+	filter := internal.Filter(common.Eq("identifier", identifier).String())
 	params := internal.GetAPIResourcesParams{
 		Filter: &filter,
 	}
@@ -127,3 +127,84 @@ func (c *APIResourceClient) Create(ctx context.Context, apiResource *APIResource
 	}
 	return resp.JSON201, nil
 }
+
+// Update patches the name, description, scopes or authorization details types of an API resource.
+func (c *APIResourceClient) Update(ctx context.Context, id string, apiResource *APIResourceUpdateModel) error {
+	resp, err := c.apiResourceClient.PatchApiResourcesApiResourceIdWithResponse(ctx, id, *apiResource)
+	if err != nil {
+		return fmt.Errorf("failed to update api resource: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update api resource: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// Delete deletes an API resource.
+func (c *APIResourceClient) Delete(ctx context.Context, id string) error {
+	resp, err := c.apiResourceClient.DeleteApiResourcesApiResourceIdWithResponse(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete api resource: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete api resource: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// GetScopes retrieves the scopes of an API resource.
+func (c *APIResourceClient) GetScopes(ctx context.Context, id string) (*[]ScopeResponseModel, error) {
+	resp, err := c.apiResourceClient.GetApiResourcesApiResourceIdScopesWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api resource scopes: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get api resource scopes: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// AddScopes adds scopes to an API resource, keeping its existing scopes.
+func (c *APIResourceClient) AddScopes(ctx context.Context, id string, scopes []ScopeCreateModel) error {
+	if err := c.Update(ctx, id, &APIResourceUpdateModel{AddedScopes: &scopes}); err != nil {
+		return fmt.Errorf("failed to add api resource scopes: %w", err)
+	}
+	return nil
+}
+
+// SetScopes replaces all scopes of an API resource with the given scopes.
+// Scopes of the API resource that are not in the given list are removed.
+func (c *APIResourceClient) SetScopes(ctx context.Context, id string, scopes []ScopeCreateModel) error {
+	resp, err := c.apiResourceClient.PutApiResourcesApiResourceIdScopesWithResponse(ctx, id, scopes)
+	if err != nil {
+		return fmt.Errorf("failed to set api resource scopes: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to set api resource scopes: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// RemoveScope removes a scope from an API resource.
+func (c *APIResourceClient) RemoveScope(ctx context.Context, id string, scopeName string) error {
+	resp, err := c.apiResourceClient.DeleteApiResourcesApiResourceIdScopesScopeNameWithResponse(ctx, id, scopeName)
+	if err != nil {
+		return fmt.Errorf("failed to remove api resource scope: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to remove api resource scope: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// UpdateScope updates the display name or description of a scope of an API resource.
+func (c *APIResourceClient) UpdateScope(ctx context.Context, id string, scopeName string, scope *ScopeUpdateModel) error {
+	resp, err := c.apiResourceClient.PatchApiResourcesApiResourceIdScopesScopeNameWithResponse(ctx, id, scopeName, *scope)
+	if err != nil {
+		return fmt.Errorf("failed to update api resource scope: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update api resource scope: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
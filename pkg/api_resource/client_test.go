@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *APIResourceClient {
+	t.Helper()
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if text, ok := body.(string); ok {
+		_, _ = w.Write([]byte(text))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestUpdate(t *testing.T) {
+	var patch APIResourceUpdateModel
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PATCH /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.Update(context.Background(), "orders-api", &APIResourceUpdateModel{
+		Name:        stringPtr("Orders API"),
+		Description: stringPtr("Manages orders"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Orders API", *patch.Name)
+	assert.Equal(t, "Manages orders", *patch.Description)
+}
+
+func TestUpdateReportsFailure(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PATCH /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusBadRequest, `{"code": "APR-60001"}`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.Update(context.Background(), "orders-api", &APIResourceUpdateModel{Name: stringPtr("Orders API")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `status 400, body: {"code": "APR-60001"}`)
+}
+
+func TestDelete(t *testing.T) {
+	deleted := false
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"DELETE /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+		"DELETE /api/server/v1/api-resources/missing-api": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusNotFound, `{"code": "APR-60002"}`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	require.NoError(t, client.Delete(context.Background(), "orders-api"))
+	assert.True(t, deleted)
+
+	err := client.Delete(context.Background(), "missing-api")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 404")
+}
+
+func TestGetScopes(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"id": "1", "name": "read_orders", "displayName": "Read orders"}]`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	scopes, err := client.GetScopes(context.Background(), "orders-api")
+	require.NoError(t, err)
+	require.Len(t, *scopes, 1)
+	assert.Equal(t, "read_orders", (*scopes)[0].Name)
+	assert.Equal(t, "Read orders", (*scopes)[0].DisplayName)
+}
+
+func TestAddScopesKeepsExistingScopes(t *testing.T) {
+	var patch APIResourceUpdateModel
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PATCH /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.AddScopes(context.Background(), "orders-api", []ScopeCreateModel{{Name: "write_orders"}})
+	require.NoError(t, err)
+	require.NotNil(t, patch.AddedScopes)
+	assert.Equal(t, []ScopeCreateModel{{Name: "write_orders"}}, *patch.AddedScopes)
+	assert.Nil(t, patch.RemovedScopes)
+}
+
+func TestSetScopes(t *testing.T) {
+	var scopes []ScopeCreateModel
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PUT /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&scopes))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.SetScopes(context.Background(), "orders-api", []ScopeCreateModel{{Name: "read_orders"}, {Name: "write_orders"}})
+	require.NoError(t, err)
+	assert.Equal(t, []ScopeCreateModel{{Name: "read_orders"}, {Name: "write_orders"}}, scopes)
+}
+
+func TestRemoveScope(t *testing.T) {
+	removed := false
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/read_orders": func(w http.ResponseWriter, r *http.Request) {
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	require.NoError(t, client.RemoveScope(context.Background(), "orders-api", "read_orders"))
+	assert.True(t, removed)
+}
+
+func TestUpdateScope(t *testing.T) {
+	var update ScopeUpdateModel
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"PATCH /api/server/v1/api-resources/orders-api/scopes/read_orders": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.UpdateScope(context.Background(), "orders-api", "read_orders", &ScopeUpdateModel{DisplayName: stringPtr("View orders")})
+	require.NoError(t, err)
+	assert.Equal(t, "View orders", *update.DisplayName)
+	assert.Nil(t, update.Description)
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asgardeo/go/pkg/api_resource/internal"
+	"github.com/asgardeo/go/pkg/common"
+)
+
+// GetAPIResourceCollections lists the API resource collections in the server.
+func (c *APIResourceClient) GetAPIResourceCollections(ctx context.Context, params *APIResourceCollectionListParamsModel) (*APIResourceCollectionListResponseModel, error) {
+	resp, err := c.apiResourceClient.GetAPIResourceCollectionsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api resource collections: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to list api resource collections: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetAPIResourceCollectionByCollectionId retrieves an API resource collection with its read and write API resources.
+func (c *APIResourceClient) GetAPIResourceCollectionByCollectionId(ctx context.Context, collectionId string) (*APIResourceCollectionResponseModel, error) {
+	resp, err := c.apiResourceClient.GetAPIResourceCollectionByCollectionIdWithResponse(ctx, collectionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api resource collection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get api resource collection: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// ExpandAPIResourceCollection resolves the API resources and scopes granted by a collection at the given access level.
+// Write access includes the API resources and scopes of read access.
+func (c *APIResourceClient) ExpandAPIResourceCollection(ctx context.Context, collectionId string, accessLevel CollectionAccessLevel) (*APIResourceCollectionExpansionModel, error) {
+	if accessLevel != CollectionAccessRead && accessLevel != CollectionAccessWrite {
+		return nil, fmt.Errorf("invalid collection access level: %s", accessLevel)
+	}
+
+	collection, err := c.GetAPIResourceCollectionByCollectionId(ctx, collectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	expansion := &APIResourceCollectionExpansionModel{
+		Id:           collection.Id,
+		Name:         collection.Name,
+		DisplayName:  collection.DisplayName,
+		AccessLevel:  accessLevel,
+		APIResources: []CollectionAPIResourceModel{},
+	}
+	if collection.ApiResources == nil {
+		return expansion, nil
+	}
+
+	items := []APIResourceCollectionItemModel{}
+	if collection.ApiResources.Read != nil {
+		items = append(items, *collection.ApiResources.Read...)
+	}
+	if accessLevel == CollectionAccessWrite && collection.ApiResources.Write != nil {
+		items = append(items, *collection.ApiResources.Write...)
+	}
+
+	// The same API resource may appear in both the read and write lists with different scopes
+	indexes := make(map[string]int)
+	seenScopes := make(map[string]map[string]struct{})
+	for _, item := range items {
+		index, exists := indexes[item.Id]
+		if !exists {
+			index = len(expansion.APIResources)
+			indexes[item.Id] = index
+			seenScopes[item.Id] = make(map[string]struct{})
+			apiResource := CollectionAPIResourceModel{
+				Id:     item.Id,
+				Name:   item.Name,
+				Scopes: []string{},
+			}
+			if item.Identifier != nil {
+				apiResource.Identifier = *item.Identifier
+			}
+			expansion.APIResources = append(expansion.APIResources, apiResource)
+		}
+		if item.Scopes == nil {
+			continue
+		}
+		for _, scope := range *item.Scopes {
+			if _, seen := seenScopes[item.Id][scope.Name]; seen {
+				continue
+			}
+			seenScopes[item.Id][scope.Name] = struct{}{}
+			expansion.APIResources[index].Scopes = append(expansion.APIResources[index].Scopes, scope.Name)
+		}
+	}
+
+	return expansion, nil
+}
+
+// SearchScopes searches the scopes of all API resources in the tenant whose name contains the given value.
+func (c *APIResourceClient) SearchScopes(ctx context.Context, name string) (*[]ScopeResponseModel, error) {
+	filter := internal.Filter(common.Co("name", name).String())
+	return c.GetScopesInTenant(ctx, &filter)
+}
+
+// GetScopesInTenant retrieves the scopes of all API resources in the tenant matching an optional filter.
+func (c *APIResourceClient) GetScopesInTenant(ctx context.Context, filter *string) (*[]ScopeResponseModel, error) {
+	if filter != nil {
+		if _, err := common.ParseFilter(*filter); err != nil {
+			return nil, fmt.Errorf("invalid scopes filter: %w", err)
+		}
+	}
+	params := internal.GetScopesParams{
+		Filter: filter,
+	}
+	resp, err := c.apiResourceClient.GetScopesWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scopes: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get scopes: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const applicationsCollection = `{
+	"id": "applications", "name": "applications", "displayName": "Application Management",
+	"apiResources": {
+		"read": [
+			{"id": "apps-api", "name": "Application Management API", "identifier": "/api/server/v1/applications", "self": "",
+				"scopes": [{"id": "1", "name": "internal_application_mgt_view", "displayName": "View applications"}]}
+		],
+		"write": [
+			{"id": "apps-api", "name": "Application Management API", "identifier": "/api/server/v1/applications", "self": "",
+				"scopes": [
+					{"id": "1", "name": "internal_application_mgt_view", "displayName": "View applications"},
+					{"id": "2", "name": "internal_application_mgt_update", "displayName": "Update applications"}
+				]},
+			{"id": "templates-api", "name": "Application Template API", "self": "",
+				"scopes": [{"id": "3", "name": "internal_application_template_update", "displayName": "Update templates"}]}
+		]
+	}
+}`
+
+func collectionRoutes() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/meta/api-resource-collections/applications": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, applicationsCollection)
+		},
+	}
+}
+
+func TestExpandAPIResourceCollectionRead(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, collectionRoutes()))
+
+	expansion, err := client.ExpandAPIResourceCollection(context.Background(), "applications", CollectionAccessRead)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Application Management", expansion.DisplayName)
+	assert.Equal(t, CollectionAccessRead, expansion.AccessLevel)
+	assert.Equal(t, []CollectionAPIResourceModel{
+		{Id: "apps-api", Identifier: "/api/server/v1/applications", Name: "Application Management API",
+			Scopes: []string{"internal_application_mgt_view"}},
+	}, expansion.APIResources)
+}
+
+func TestExpandAPIResourceCollectionWriteIncludesRead(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, collectionRoutes()))
+
+	expansion, err := client.ExpandAPIResourceCollection(context.Background(), "applications", CollectionAccessWrite)
+	require.NoError(t, err)
+
+	assert.Equal(t, []CollectionAPIResourceModel{
+		{Id: "apps-api", Identifier: "/api/server/v1/applications", Name: "Application Management API",
+			Scopes: []string{"internal_application_mgt_view", "internal_application_mgt_update"}},
+		{Id: "templates-api", Name: "Application Template API",
+			Scopes: []string{"internal_application_template_update"}},
+	}, expansion.APIResources)
+}
+
+func TestExpandAPIResourceCollectionRejectsUnknownAccessLevel(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, map[string]http.HandlerFunc{}))
+
+	_, err := client.ExpandAPIResourceCollection(context.Background(), "applications", CollectionAccessLevel("admin"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid collection access level: admin")
+}
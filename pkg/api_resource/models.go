@@ -33,3 +33,62 @@ type APIResourceListItemModel = internal.APIResourceListItem
 type APIResourceCreateModel = internal.AddAPIResourceJSONRequestBody
 
 type ScopeCreateModel = internal.ScopeCreationModel
+
+type APIResourceUpdateModel = internal.PatchApiResourcesApiResourceIdJSONRequestBody
+
+type ScopeUpdateModel = internal.ScopePatchModel
+
+type ScopeResponseModel = internal.ScopeGetModel
+
+// ScopeRenameModel describes a scope whose display name changed during reconciliation
+type ScopeRenameModel struct {
+	Name           string `json:"name"`
+	OldDisplayName string `json:"oldDisplayName"`
+	NewDisplayName string `json:"newDisplayName"`
+}
+
+// ScopeReconciliationResultModel describes the changes made when replacing the scopes of an API resource
+type ScopeReconciliationResultModel struct {
+	Added    []string           `json:"added"`
+	Removed  []string           `json:"removed"`
+	Renamed  []ScopeRenameModel `json:"renamed"`
+	Updated  []string           `json:"updated"`
+	Warnings []string           `json:"warnings,omitempty"`
+}
+
+type AuthorizationDetailsTypeCreateModel = internal.AuthorizationDetailsTypesCreationModel
+
+type AuthorizationDetailsTypeResponseModel = internal.AuthorizationDetailsTypesGetModel
+
+type APIResourceCollectionListParamsModel = internal.GetAPIResourceCollectionsParams
+
+type APIResourceCollectionListResponseModel = internal.APIResourceCollectionListResponse
+
+type APIResourceCollectionResponseModel = internal.APIResourceCollectionResponse
+
+type APIResourceCollectionItemModel = internal.APIResourceCollectionItem
+
+// CollectionAccessLevel is the level of access granted by an API resource collection
+type CollectionAccessLevel string
+
+const (
+	CollectionAccessRead  CollectionAccessLevel = "read"
+	CollectionAccessWrite CollectionAccessLevel = "write"
+)
+
+// CollectionAPIResourceModel is an API resource of a collection with the scopes granted at an access level
+type CollectionAPIResourceModel struct {
+	Id         string   `json:"id"`
+	Identifier string   `json:"identifier"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+}
+
+// APIResourceCollectionExpansionModel is an API resource collection expanded into its API resources and scopes
+type APIResourceCollectionExpansionModel struct {
+	Id           string                       `json:"id"`
+	Name         string                       `json:"name"`
+	DisplayName  string                       `json:"displayName"`
+	AccessLevel  CollectionAccessLevel        `json:"accessLevel"`
+	APIResources []CollectionAPIResourceModel `json:"apiResources"`
+}
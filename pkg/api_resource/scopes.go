@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// AuthorizedScopesLookupFunc returns the names of the scopes of an API resource authorized to an application.
+// ApplicationClient.GetAuthorizedScopeNames implements it.
+type AuthorizedScopesLookupFunc func(ctx context.Context, appId string, apiResourceId string) ([]string, error)
+
+// ReplaceScopes reconciles the scopes of an API resource with the given scopes. Scopes missing from the
+// API resource are added, scopes not in the given list are removed and scopes with a changed display name
+// or description are updated. When authorizedScopes is set, a warning is reported for each removed scope
+// still authorized to an application.
+func (c *APIResourceClient) ReplaceScopes(ctx context.Context, id string, scopes []ScopeCreateModel,
+	authorizedScopes AuthorizedScopesLookupFunc) (*ScopeReconciliationResultModel, error) {
+	currentScopes, err := c.GetScopes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	currentScopeMap := make(map[string]ScopeResponseModel)
+	if currentScopes != nil {
+		for _, scope := range *currentScopes {
+			currentScopeMap[scope.Name] = scope
+		}
+	}
+	desiredScopeMap := make(map[string]ScopeCreateModel)
+	for _, scope := range scopes {
+		desiredScopeMap[scope.Name] = scope
+	}
+
+	result := &ScopeReconciliationResultModel{
+		Added:   []string{},
+		Removed: []string{},
+		Renamed: []ScopeRenameModel{},
+		Updated: []string{},
+	}
+	var addedScopes []ScopeCreateModel
+	scopeUpdates := make(map[string]ScopeUpdateModel)
+	for _, scope := range scopes {
+		currentScope, exists := currentScopeMap[scope.Name]
+		if !exists {
+			addedScopes = append(addedScopes, scope)
+			result.Added = append(result.Added, scope.Name)
+			continue
+		}
+
+		update := ScopeUpdateModel{}
+		changed := false
+		if scope.DisplayName != nil && *scope.DisplayName != currentScope.DisplayName {
+			update.DisplayName = scope.DisplayName
+			changed = true
+			result.Renamed = append(result.Renamed, ScopeRenameModel{
+				Name:           scope.Name,
+				OldDisplayName: currentScope.DisplayName,
+				NewDisplayName: *scope.DisplayName,
+			})
+		}
+		if scope.Description != nil && (currentScope.Description == nil || *scope.Description != *currentScope.Description) {
+			update.Description = scope.Description
+			if !changed {
+				result.Updated = append(result.Updated, scope.Name)
+			}
+			changed = true
+		}
+		if changed {
+			scopeUpdates[scope.Name] = update
+		}
+	}
+	for name := range currentScopeMap {
+		if _, exists := desiredScopeMap[name]; !exists {
+			result.Removed = append(result.Removed, name)
+		}
+	}
+	sort.Strings(result.Removed)
+
+	if len(result.Removed) > 0 && authorizedScopes != nil {
+		warnings, err := c.findAuthorizedScopeUsages(ctx, id, result.Removed, authorizedScopes)
+		if err != nil {
+			return nil, err
+		}
+		result.Warnings = warnings
+	}
+
+	// Scopes are added through the API resource patch, which keeps the existing scopes. Removed scopes are
+	// deleted one by one as the patch does not support removing scopes.
+	if len(addedScopes) > 0 {
+		if err := c.AddScopes(ctx, id, addedScopes); err != nil {
+			return nil, err
+		}
+	}
+	for name, update := range scopeUpdates {
+		update := update
+		if err := c.UpdateScope(ctx, id, name, &update); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range result.Removed {
+		if err := c.RemoveScope(ctx, id, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// findAuthorizedScopeUsages returns a warning for each of the given scopes that is authorized to an application
+func (c *APIResourceClient) findAuthorizedScopeUsages(ctx context.Context, id string, scopeNames []string,
+	authorizedScopes AuthorizedScopesLookupFunc) ([]string, error) {
+	apiResource, err := c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if apiResource.SubscribedApplications == nil {
+		return nil, nil
+	}
+
+	scopeSet := make(map[string]struct{})
+	for _, name := range scopeNames {
+		scopeSet[name] = struct{}{}
+	}
+
+	var warnings []string
+	for _, app := range *apiResource.SubscribedApplications {
+		if app.Id == nil {
+			continue
+		}
+		authorizedScopeNames, err := authorizedScopes(ctx, *app.Id, id)
+		if err != nil {
+			return nil, err
+		}
+		appName := *app.Id
+		if app.Name != nil {
+			appName = *app.Name
+		}
+		for _, scopeName := range authorizedScopeNames {
+			if _, ok := scopeSet[scopeName]; ok {
+				warnings = append(warnings, fmt.Sprintf("removed scope '%s' is still authorized to application '%s'", scopeName, appName))
+			}
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package api_resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceScopes(t *testing.T) {
+	var added []ScopeCreateModel
+	var removed []string
+	updates := map[string]ScopeUpdateModel{}
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[
+				{"id": "1", "name": "read_orders", "displayName": "Read orders"},
+				{"id": "2", "name": "write_orders", "displayName": "Write orders", "description": "Create orders"},
+				{"id": "3", "name": "delete_orders", "displayName": "Delete orders"},
+				{"id": "4", "name": "export_orders", "displayName": "Export orders"}
+			]`)
+		},
+		"GET /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "orders-api", "name": "Orders", "identifier": "https://orders.example.com", "self": "",
+				"subscribedApplications": [{"id": "portal-app", "name": "Portal"}]}`)
+		},
+		"PATCH /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			var patch APIResourceUpdateModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			require.NotNil(t, patch.AddedScopes)
+			added = append(added, *patch.AddedScopes...)
+			w.WriteHeader(http.StatusNoContent)
+		},
+		"PATCH /api/server/v1/api-resources/orders-api/scopes/read_orders": func(w http.ResponseWriter, r *http.Request) {
+			var update ScopeUpdateModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			updates["read_orders"] = update
+			w.WriteHeader(http.StatusOK)
+		},
+		"PATCH /api/server/v1/api-resources/orders-api/scopes/write_orders": func(w http.ResponseWriter, r *http.Request) {
+			var update ScopeUpdateModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			updates["write_orders"] = update
+			w.WriteHeader(http.StatusOK)
+		},
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/delete_orders": func(w http.ResponseWriter, r *http.Request) {
+			removed = append(removed, "delete_orders")
+			w.WriteHeader(http.StatusNoContent)
+		},
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/export_orders": func(w http.ResponseWriter, r *http.Request) {
+			removed = append(removed, "export_orders")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	authorizedScopes := func(ctx context.Context, appId string, apiResourceId string) ([]string, error) {
+		assert.Equal(t, "portal-app", appId)
+		assert.Equal(t, "orders-api", apiResourceId)
+		return []string{"read_orders", "export_orders"}, nil
+	}
+	result, err := client.ReplaceScopes(context.Background(), "orders-api", []ScopeCreateModel{
+		{Name: "read_orders", DisplayName: stringPtr("View orders")},
+		{Name: "write_orders", DisplayName: stringPtr("Write orders"), Description: stringPtr("Create and edit orders")},
+		{Name: "refund_orders", DisplayName: stringPtr("Refund orders")},
+	}, authorizedScopes)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"refund_orders"}, result.Added)
+	assert.Equal(t, []string{"delete_orders", "export_orders"}, result.Removed)
+	assert.Equal(t, []ScopeRenameModel{{Name: "read_orders", OldDisplayName: "Read orders", NewDisplayName: "View orders"}}, result.Renamed)
+	assert.Equal(t, []string{"write_orders"}, result.Updated)
+	assert.Equal(t, []string{"removed scope 'export_orders' is still authorized to application 'Portal'"}, result.Warnings)
+
+	assert.Equal(t, []ScopeCreateModel{{Name: "refund_orders", DisplayName: stringPtr("Refund orders")}}, added)
+	assert.Equal(t, "View orders", *updates["read_orders"].DisplayName)
+	assert.Nil(t, updates["write_orders"].DisplayName)
+	assert.Equal(t, "Create and edit orders", *updates["write_orders"].Description)
+	sort.Strings(removed)
+	assert.Equal(t, []string{"delete_orders", "export_orders"}, removed)
+}
+
+func TestReplaceScopesWithoutChanges(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"id": "1", "name": "read_orders", "displayName": "Read orders"}]`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	result, err := client.ReplaceScopes(context.Background(), "orders-api", []ScopeCreateModel{
+		{Name: "read_orders", DisplayName: stringPtr("Read orders")},
+	}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Added)
+	assert.Empty(t, result.Removed)
+	assert.Empty(t, result.Renamed)
+	assert.Empty(t, result.Updated)
+	assert.Empty(t, result.Warnings)
+}
+
+func TestReplaceScopesReportsAuthorizedAPIsFailure(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"id": "1", "name": "read_orders", "displayName": "Read orders"}]`)
+		},
+		"GET /api/server/v1/api-resources/orders-api": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "orders-api", "name": "Orders", "identifier": "https://orders.example.com", "self": "",
+				"subscribedApplications": [{"id": "portal-app", "name": "Portal"}]}`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	_, err := client.ReplaceScopes(context.Background(), "orders-api", nil,
+		func(ctx context.Context, appId string, apiResourceId string) ([]string, error) {
+			return nil, errors.New("failed to get authorized APIs: status 403")
+		})
+	assert.EqualError(t, err, "failed to get authorized APIs: status 403")
+}
+
+func TestReplaceScopesWithoutLookupSkipsWarnings(t *testing.T) {
+	removed := false
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/api-resources/orders-api/scopes": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"id": "1", "name": "read_orders", "displayName": "Read orders"}]`)
+		},
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/read_orders": func(w http.ResponseWriter, r *http.Request) {
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := newTestClient(t, server)
+
+	result, err := client.ReplaceScopes(context.Background(), "orders-api", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read_orders"}, result.Removed)
+	assert.Empty(t, result.Warnings)
+	assert.True(t, removed)
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+)
+
+const defaultCertificateExpiryWarningWindow = 30 * 24 * time.Hour
+
+// GetCertificate retrieves the certificate or JWKS endpoint registered for an application.
+// Returns nil if the application has no certificate configured.
+func (c *ApplicationClient) GetCertificate(ctx context.Context, appID string) (*ApplicationCertificateModel, error) {
+	appDetails, err := c.fetchApplicationDetails(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application certificate: %w", err)
+	}
+
+	if appDetails.AdvancedConfigurations == nil || appDetails.AdvancedConfigurations.Certificate == nil {
+		return nil, nil
+	}
+	certificate := appDetails.AdvancedConfigurations.Certificate
+	if certificate.Type == nil || certificate.Value == nil || *certificate.Value == "" {
+		return nil, nil
+	}
+
+	result := &ApplicationCertificateModel{
+		Type:  CertificateType(strings.ToUpper(*certificate.Type)),
+		Value: *certificate.Value,
+	}
+	if result.Type == CertificateTypePEM {
+		cert, err := parseCertificateValue(*certificate.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse application certificate: %w", err)
+		}
+		populateCertificateDetails(result, cert)
+	}
+
+	return result, nil
+}
+
+// UpdateCertificate registers an x509 certificate for an application after validating its expiry
+func (c *ApplicationClient) UpdateCertificate(ctx context.Context, appID string, cert *x509.Certificate, opts *CertificateOptions) (*CertificateUpdateResultModel, error) {
+	if cert == nil {
+		return nil, fmt.Errorf("certificate is required")
+	}
+
+	warnings, err := validateCertificateExpiry(cert, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pemValue := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	if err := c.patchCertificate(ctx, appID, CertificateTypePEM, pemValue); err != nil {
+		return nil, err
+	}
+
+	result := &CertificateUpdateResultModel{
+		Certificate: ApplicationCertificateModel{
+			Type:  CertificateTypePEM,
+			Value: pemValue,
+		},
+		Warnings: warnings,
+	}
+	populateCertificateDetails(&result.Certificate, cert)
+	return result, nil
+}
+
+// UpdateCertificateFromPEM registers a PEM encoded certificate for an application
+func (c *ApplicationClient) UpdateCertificateFromPEM(ctx context.Context, appID string, pemData []byte, opts *CertificateOptions) (*CertificateUpdateResultModel, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("invalid PEM data: no CERTIFICATE block found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM certificate: %w", err)
+	}
+
+	return c.UpdateCertificate(ctx, appID, cert, opts)
+}
+
+// UpdateCertificateFromDER registers a DER encoded certificate for an application
+func (c *ApplicationClient) UpdateCertificateFromDER(ctx context.Context, appID string, derData []byte, opts *CertificateOptions) (*CertificateUpdateResultModel, error) {
+	cert, err := x509.ParseCertificate(derData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse DER certificate: %w", err)
+	}
+
+	return c.UpdateCertificate(ctx, appID, cert, opts)
+}
+
+// UpdateJWKSURI configures an application to resolve its keys from a JWKS endpoint instead of a certificate
+func (c *ApplicationClient) UpdateJWKSURI(ctx context.Context, appID string, jwksURI string) error {
+	parsedURL, err := url.Parse(jwksURI)
+	if err != nil {
+		return fmt.Errorf("JWKS URI is not in valid URL format: %w", err)
+	}
+	if parsedURL.Scheme != "https" || parsedURL.Host == "" {
+		return fmt.Errorf("JWKS URI must be an absolute https URL: %s", jwksURI)
+	}
+
+	return c.patchCertificate(ctx, appID, CertificateTypeJWKS, jwksURI)
+}
+
+func (c *ApplicationClient) patchCertificate(ctx context.Context, appID string, certificateType CertificateType, value string) error {
+	patchData := internal.ApplicationPatchModel{
+		AdvancedConfigurations: &internal.AdvancedApplicationConfiguration{
+			Certificate: &internal.Certificate{
+				Type:  stringPtr(string(certificateType)),
+				Value: &value,
+			},
+		},
+	}
+	resp, err := c.apiClient.PatchApplicationWithResponse(ctx, appID, patchData)
+	if err != nil {
+		return fmt.Errorf("failed to update application certificate: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update application certificate: status %d, body: %s",
+			resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// validateCertificateExpiry rejects certificates outside their validity period and
+// returns a warning when the certificate expires within the configured window
+func validateCertificateExpiry(cert *x509.Certificate, opts *CertificateOptions) ([]string, error) {
+	now := time.Now()
+	if now.Before(cert.NotBefore) {
+		return nil, fmt.Errorf("certificate is not valid before %s", cert.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(cert.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %s", cert.NotAfter.Format(time.RFC3339))
+	}
+
+	window := defaultCertificateExpiryWarningWindow
+	if opts != nil && opts.ExpiryWarningWindow > 0 {
+		window = opts.ExpiryWarningWindow
+	}
+
+	var warnings []string
+	if now.Add(window).After(cert.NotAfter) {
+		warnings = append(warnings, fmt.Sprintf("certificate '%s' expires on %s",
+			cert.Subject.String(), cert.NotAfter.Format(time.RFC3339)))
+	}
+	return warnings, nil
+}
+
+// parseCertificateValue parses a certificate value returned by the server, which may be
+// a PEM string or a base64 encoded PEM string
+func parseCertificateValue(value string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("certificate value is neither PEM nor base64 encoded PEM")
+		}
+		block, _ = pem.Decode(decoded)
+		if block == nil {
+			return x509.ParseCertificate(decoded)
+		}
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func populateCertificateDetails(model *ApplicationCertificateModel, cert *x509.Certificate) {
+	notBefore := cert.NotBefore
+	notAfter := cert.NotAfter
+	model.Subject = cert.Subject.String()
+	model.Issuer = cert.Issuer.String()
+	model.SerialNumber = cert.SerialNumber.String()
+	model.NotBefore = &notBefore
+	model.NotAfter = &notAfter
+}
@@ -20,14 +20,17 @@ package application
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"sort"
 	"strings"
 
+	"github.com/asgardeo/go/pkg/api_resource"
 	"github.com/asgardeo/go/pkg/application/internal"
 	"github.com/asgardeo/go/pkg/authenticator"
 	"github.com/asgardeo/go/pkg/claim"
+	"github.com/asgardeo/go/pkg/claim/wellknown"
 	"github.com/asgardeo/go/pkg/common"
 	"github.com/asgardeo/go/pkg/config"
 	"github.com/asgardeo/go/pkg/identity_provider"
@@ -156,35 +159,9 @@ func (c *ApplicationClient) CreateWebAppWithSSR(ctx context.Context, name string
 // GetByName finds an application by name and returns its details
 // todo: improve application details being fetched beyond appId, name, clientId and clientSecret
 func (c *ApplicationClient) GetByName(ctx context.Context, name string) (*ApplicationBasicInfoResponseModel, error) {
-	filter := fmt.Sprintf("name eq %s", name)
-	excludeSystemPortals := true
-
-	params := internal.GetAllApplicationsParams{
-		Filter:               &filter,
-		ExcludeSystemPortals: &excludeSystemPortals,
-		Attributes:           stringPtr("templateId,clientId"),
-	}
-
-	resp, err := c.apiClient.GetAllApplicationsWithResponse(ctx, &params)
+	targetApp, err := c.findApplicationByName(ctx, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find application: %w", err)
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to find application: status %d, body: %s",
-			resp.StatusCode(), string(resp.Body))
-	}
-
-	if resp.JSON200 == nil || resp.JSON200.Applications == nil || len(*resp.JSON200.Applications) == 0 {
-		return nil, fmt.Errorf("application with name '%s' not found", name)
-	}
-
-	var targetApp *internal.ApplicationListItem
-	for _, app := range *resp.JSON200.Applications {
-		if app.Name != nil && *app.Name == name {
-			targetApp = &app
-			break
-		}
+		return nil, err
 	}
 
 	if targetApp == nil {
@@ -197,7 +174,7 @@ func (c *ApplicationClient) GetByName(ctx context.Context, name string) (*Applic
 // GetByClienId finds an application by clientId and returns its details
 // todo: improve application details being fetched beyond appId, name, clientId and clientSecret
 func (c *ApplicationClient) GetByClienId(ctx context.Context, clientId string) (*ApplicationBasicInfoResponseModel, error) {
-	filter := fmt.Sprintf("clientId eq %s", clientId)
+	filter := common.Eq("clientId", clientId).String()
 	excludeSystemPortals := true
 
 	params := internal.GetAllApplicationsParams{
@@ -264,6 +241,105 @@ func (c *ApplicationClient) GetAuthorizedAPIs(ctx context.Context, appID string)
 	return resp.JSON200, nil
 }
 
+// GetAuthorizedScopeNames returns the names of the scopes of an API resource authorized to an application
+func (c *ApplicationClient) GetAuthorizedScopeNames(ctx context.Context, appID string, apiResourceId string) ([]string, error) {
+	authorizedAPIs, err := c.GetAuthorizedAPIs(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeNames := []string{}
+	if authorizedAPIs == nil {
+		return scopeNames, nil
+	}
+	for _, authorizedAPI := range *authorizedAPIs {
+		if authorizedAPI.Id == nil || *authorizedAPI.Id != apiResourceId || authorizedAPI.AuthorizedScopes == nil {
+			continue
+		}
+		for _, scope := range *authorizedAPI.AuthorizedScopes {
+			if scope.Name != nil {
+				scopeNames = append(scopeNames, *scope.Name)
+			}
+		}
+	}
+	return scopeNames, nil
+}
+
+// AuthorizeAPIResourceCollection authorizes an application for every API resource of a collection,
+// such as the management API collections, at the given access level. Scopes are added to APIs the
+// application is already authorized for.
+func (c *ApplicationClient) AuthorizeAPIResourceCollection(ctx context.Context, appID string, collectionId string, accessLevel api_resource.CollectionAccessLevel) error {
+	apiResourceClient, err := api_resource.New(c.config)
+	if err != nil {
+		return fmt.Errorf("failed to create api resource client: %w", err)
+	}
+	collection, err := apiResourceClient.ExpandAPIResourceCollection(ctx, collectionId, accessLevel)
+	if err != nil {
+		return err
+	}
+
+	authorizedAPIs, err := c.GetAuthorizedAPIs(ctx, appID)
+	if err != nil {
+		return err
+	}
+	authorizedScopes := make(map[string]map[string]struct{})
+	if authorizedAPIs != nil {
+		for _, authorizedAPI := range *authorizedAPIs {
+			if authorizedAPI.Id == nil {
+				continue
+			}
+			scopes := make(map[string]struct{})
+			if authorizedAPI.AuthorizedScopes != nil {
+				for _, scope := range *authorizedAPI.AuthorizedScopes {
+					if scope.Name != nil {
+						scopes[*scope.Name] = struct{}{}
+					}
+				}
+			}
+			authorizedScopes[*authorizedAPI.Id] = scopes
+		}
+	}
+
+	for _, apiResource := range collection.APIResources {
+		apiId := apiResource.Id
+		existingScopes, authorized := authorizedScopes[apiId]
+		if !authorized {
+			scopes := apiResource.Scopes
+			apiAuthorization := AuthorizedAPICreateModel{
+				Id:               &apiId,
+				PolicyIdentifier: stringPtr("RBAC"),
+				Scopes:           &scopes,
+			}
+			if err := c.AuthorizeAPI(ctx, appID, apiAuthorization); err != nil {
+				return fmt.Errorf("failed to authorize API '%s' of collection '%s': %w", apiResource.Name, collection.Name, err)
+			}
+			continue
+		}
+
+		var addedScopes []string
+		for _, scope := range apiResource.Scopes {
+			if _, exists := existingScopes[scope]; !exists {
+				addedScopes = append(addedScopes, scope)
+			}
+		}
+		if len(addedScopes) == 0 {
+			continue
+		}
+		resp, err := c.apiClient.PatchAuthorizedAPIWithResponse(ctx, appID, apiId, internal.PatchAuthorizedAPIJSONRequestBody{
+			AddedScopes: &addedScopes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to authorize API '%s' of collection '%s': %w", apiResource.Name, collection.Name, err)
+		}
+		if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
+			return fmt.Errorf("failed to authorize API '%s' of collection '%s': status %d, body: %s",
+				apiResource.Name, collection.Name, resp.StatusCode(), string(resp.Body))
+		}
+	}
+
+	return nil
+}
+
 // UpdateBasicInfo updates basic information of an existing application
 func (c *ApplicationClient) UpdateBasicInfo(ctx context.Context, appId string, updateModel ApplicationBasicInfoUpdateModel) error {
 	patchData := convertBasicInfoUpdateModelToApplicationPatchModel(updateModel)
@@ -318,27 +394,8 @@ func (c *ApplicationClient) UpdateOAuthConfig(ctx context.Context, applicationId
 	}
 
 	if config.CallbackURLs != nil {
-		if len(*config.CallbackURLs) == 1 {
-			// If there's only one callback URL, use it directly without "regexp="
-			updatedConfig.CallbackURLs = config.CallbackURLs
-		} else {
-			// Construct "regexp=(callback1|callback2|...|callbackN)" for multiple callback URLs
-			var callbackRegex string
-			for i, callback := range *config.CallbackURLs {
-				if i == 0 {
-					callbackRegex = fmt.Sprintf("regexp=(%s", callback)
-				} else {
-					callbackRegex = fmt.Sprintf("%s|%s", callbackRegex, callback)
-				}
-			}
-			if len(*config.CallbackURLs) > 0 {
-				callbackRegex = fmt.Sprintf("%s)", callbackRegex)
-			} else {
-				callbackRegex = ""
-			}
-
-			updatedConfig.CallbackURLs = &[]string{callbackRegex}
-		}
+		callbackURLs := buildCallbackURLs(*config.CallbackURLs)
+		updatedConfig.CallbackURLs = &callbackURLs
 	}
 
 	if config.Logout != nil {
@@ -395,6 +452,47 @@ func (c *ApplicationClient) UpdateLoginFlow(ctx context.Context, appId string, l
 	return nil
 }
 
+// AddIdentityProviderToLoginFlow adds an identity provider as an option to the first step of an application's login flow.
+// If the application has no login flow steps, a first step is created that offers only the identity provider, or
+// also username and password sign-in when includeBasicAuthenticator is set. Existing steps are never removed.
+func (c *ApplicationClient) AddIdentityProviderToLoginFlow(ctx context.Context, appId string, idpName string, authenticatorId string,
+	includeBasicAuthenticator bool) error {
+	authenticatorName, err := base64.RawURLEncoding.DecodeString(authenticatorId)
+	if err != nil {
+		return fmt.Errorf("invalid authenticator ID '%s': %w", authenticatorId, err)
+	}
+
+	appDetails, err := c.fetchApplicationDetails(ctx, appId)
+	if err != nil {
+		return fmt.Errorf("failed to add identity provider to login flow: %w", err)
+	}
+
+	var loginFlow LoginFlowUpdateModel
+	if appDetails.AuthenticationSequence != nil {
+		loginFlow = *appDetails.AuthenticationSequence
+	}
+	if loginFlow.Steps == nil || len(*loginFlow.Steps) == 0 {
+		firstStep := LoginFlowStepModel{Id: 1, Options: []AuthenticatorModel{}}
+		if includeBasicAuthenticator {
+			firstStep.Options = append(firstStep.Options, AuthenticatorModel{Idp: "LOCAL", Authenticator: "BasicAuthenticator"})
+		}
+		loginFlow.Steps = &[]LoginFlowStepModel{firstStep}
+	}
+
+	steps := *loginFlow.Steps
+	for _, option := range steps[0].Options {
+		if option.Idp == idpName {
+			return nil
+		}
+	}
+	steps[0].Options = append(steps[0].Options, AuthenticatorModel{Idp: idpName, Authenticator: string(authenticatorName)})
+	// The default login flow of the tenant ignores the steps of the application
+	userDefined := internal.USERDEFINED
+	loginFlow.Type = &userDefined
+
+	return c.UpdateLoginFlow(ctx, appId, loginFlow)
+}
+
 // GenerateLoginFlow initiates the login flow generation process for an application.
 func (c *ApplicationClient) GenerateLoginFlow(ctx context.Context, prompt string) (*LoginFlowGenerateResponseModel, error) {
 	availableAuthenticators, err := c.buildAvailableAuthenticators(ctx)
@@ -450,6 +548,66 @@ func (c *ApplicationClient) GetLoginFlowGenerationResult(ctx context.Context, fl
 	return &loginFlowResultResponse, nil
 }
 
+// GetConfiguredAuthenticators retrieves the authenticators configured in each step of an application's login flow.
+func (c *ApplicationClient) GetConfiguredAuthenticators(ctx context.Context, appId string) (*[]ConfiguredAuthenticatorsModel, error) {
+	resp, err := c.apiClient.GetConfiguredAuthenticatorsWithResponse(ctx, appId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configured authenticators: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get configured authenticators: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetLoginFlow retrieves the current login flow of an application with authenticator and identity provider details resolved.
+func (c *ApplicationClient) GetLoginFlow(ctx context.Context, appId string) (*LoginFlowResponseModel, error) {
+	resolver, err := c.buildAuthenticatorResolver(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve login flow authenticators: %w", err)
+	}
+	return c.getResolvedLoginFlow(ctx, appId, resolver)
+}
+
+func (c *ApplicationClient) getResolvedLoginFlow(ctx context.Context, appId string, resolver *authenticatorResolver) (*LoginFlowResponseModel, error) {
+	appDetails, err := c.fetchApplicationDetails(ctx, appId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login flow: %w", err)
+	}
+
+	var loginFlow LoginFlowUpdateModel
+	if appDetails.AuthenticationSequence != nil {
+		loginFlow = *appDetails.AuthenticationSequence
+	}
+
+	if loginFlow.Steps == nil || len(*loginFlow.Steps) == 0 {
+		// Fall back to the configured authenticators when the application does not define its own steps
+		configuredAuthenticators, err := c.GetConfiguredAuthenticators(ctx, appId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get login flow: %w", err)
+		}
+		steps := convertConfiguredAuthenticatorsToLoginFlowSteps(configuredAuthenticators)
+		loginFlow.Steps = &steps
+	}
+
+	result := &LoginFlowResponseModel{
+		LoginFlow: loginFlow,
+		Steps:     make([]LoginFlowStepDetailsModel, 0, len(*loginFlow.Steps)),
+	}
+	for _, step := range *loginFlow.Steps {
+		stepDetails := LoginFlowStepDetailsModel{
+			Id:      step.Id,
+			Options: make([]LoginFlowOptionDetailsModel, 0, len(step.Options)),
+		}
+		for _, option := range step.Options {
+			stepDetails.Options = append(stepDetails.Options, resolver.resolve(option))
+		}
+		result.Steps = append(result.Steps, stepDetails)
+	}
+
+	return result, nil
+}
+
 func (c *ApplicationClient) buildSPARequest(name, redirectURL string) (internal.ApplicationModel, error) {
 	allowedOrigins, err := extractOrigins(redirectURL)
 	if err != nil {
@@ -485,7 +643,7 @@ func (c *ApplicationClient) buildSPARequest(name, redirectURL string) (internal.
 			RequestedClaims: &[]internal.RequestedClaimConfiguration{
 				{
 					Claim: internal.Claim{
-						Uri: "http://wso2.org/claims/username",
+						Uri: wellknown.ClaimUsername,
 					},
 				},
 			},
@@ -629,7 +787,7 @@ func (c *ApplicationClient) buildWebAppWithSSRRequest(name, redirectURL string)
 			RequestedClaims: &[]internal.RequestedClaimConfiguration{
 				{
 					Claim: internal.Claim{
-						Uri: "http://wso2.org/claims/username",
+						Uri: wellknown.ClaimUsername,
 					},
 				},
 			},
@@ -728,6 +886,41 @@ func (c *ApplicationClient) processCreateAppResponse(ctx context.Context, resp *
 	return nil, err
 }
 
+// findApplicationByName returns the application with the exact given name, or nil if no such application exists
+func (c *ApplicationClient) findApplicationByName(ctx context.Context, name string) (*internal.ApplicationListItem, error) {
+	filter := common.Eq("name", name).String()
+	excludeSystemPortals := true
+
+	params := internal.GetAllApplicationsParams{
+		Filter:               &filter,
+		ExcludeSystemPortals: &excludeSystemPortals,
+		Attributes:           stringPtr("templateId,clientId"),
+	}
+
+	resp, err := c.apiClient.GetAllApplicationsWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find application: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to find application: status %d, body: %s",
+			resp.StatusCode(), string(resp.Body))
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Applications == nil {
+		return nil, nil
+	}
+
+	for _, app := range *resp.JSON200.Applications {
+		if app.Name != nil && *app.Name == name {
+			targetApp := app
+			return &targetApp, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (c *ApplicationClient) fetchInboundOAuthDetails(ctx context.Context, appID string) (*internal.OpenIDConnectConfiguration, error) {
 	resp, err := c.apiClient.GetInboundOAuthConfigurationWithResponse(ctx, appID)
 	if err != nil {
@@ -1097,3 +1290,186 @@ func (c *ApplicationClient) getAuthorizedOIDCScopes(ctx context.Context, claimCo
 
 	return result, nil
 }
+
+// authenticatorResolver resolves the authenticator and identity provider details referenced in a login flow
+type authenticatorResolver struct {
+	localAuthenticators map[string]authenticator.AuthenticatorInfoResponseModel
+	// Federated authenticators are listed once per identity provider, under the name of the identity provider
+	federatedAuthenticators map[string]authenticator.AuthenticatorInfoResponseModel
+	identityProviders       map[string]resolvedIdentityProvider
+}
+
+type resolvedIdentityProvider struct {
+	id                     string
+	defaultAuthenticatorId string
+	authenticatorIds       map[string]string
+}
+
+func (c *ApplicationClient) buildAuthenticatorResolver(ctx context.Context) (*authenticatorResolver, error) {
+	authenticatorClient, err := authenticator.New(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator client: %w", err)
+	}
+	authenticators, err := authenticatorClient.ListByFilter(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authenticators: %w", err)
+	}
+
+	resolver := &authenticatorResolver{
+		localAuthenticators:     make(map[string]authenticator.AuthenticatorInfoResponseModel),
+		federatedAuthenticators: make(map[string]authenticator.AuthenticatorInfoResponseModel),
+		identityProviders:       make(map[string]resolvedIdentityProvider),
+	}
+	for _, listedAuthenticator := range *authenticators {
+		if listedAuthenticator.Name == nil || listedAuthenticator.Type == nil {
+			continue
+		}
+		switch *listedAuthenticator.Type {
+		case authenticator.AuthenticatorTypeLocal:
+			resolver.localAuthenticators[strings.ToLower(*listedAuthenticator.Name)] = listedAuthenticator
+		case authenticator.AuthenticatorTypeFederated:
+			resolver.federatedAuthenticators[*listedAuthenticator.Name] = listedAuthenticator
+		}
+	}
+
+	identityProviderClient, err := identity_provider.New(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity provider client: %w", err)
+	}
+	requiredAttributes := "federatedAuthenticators"
+	identityProviders, err := listAllIdentityProviders(ctx, identityProviderClient, &requiredAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %w", err)
+	}
+
+	for _, idp := range identityProviders {
+		if idp.Name == nil {
+			continue
+		}
+		resolved := resolvedIdentityProvider{
+			authenticatorIds: make(map[string]string),
+		}
+		if idp.Id != nil {
+			resolved.id = *idp.Id
+		}
+		if idp.FederatedAuthenticators != nil {
+			if idp.FederatedAuthenticators.DefaultAuthenticatorId != nil {
+				resolved.defaultAuthenticatorId = *idp.FederatedAuthenticators.DefaultAuthenticatorId
+			}
+			if idp.FederatedAuthenticators.Authenticators != nil {
+				for _, federatedAuthenticator := range *idp.FederatedAuthenticators.Authenticators {
+					if federatedAuthenticator.AuthenticatorId == nil || federatedAuthenticator.Name == nil {
+						continue
+					}
+					resolved.authenticatorIds[*federatedAuthenticator.Name] = *federatedAuthenticator.AuthenticatorId
+				}
+			}
+		}
+		resolver.identityProviders[*idp.Name] = resolved
+	}
+
+	return resolver, nil
+}
+
+// listAllIdentityProviders pages through the identity providers of the tenant
+func listAllIdentityProviders(ctx context.Context, identityProviderClient *identity_provider.IdentityProviderClient,
+	requiredAttributes *string) ([]identity_provider.IdentityProviderListItemModel, error) {
+	identityProviders := []identity_provider.IdentityProviderListItemModel{}
+	limit := identityProviderPageSize
+	offset := int32(0)
+	for {
+		idpList, err := identityProviderClient.List(ctx, &identity_provider.IdentityProviderListParamsModel{
+			Limit:              &limit,
+			Offset:             &offset,
+			RequiredAttributes: requiredAttributes,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if idpList == nil || idpList.IdentityProviders == nil || len(*idpList.IdentityProviders) == 0 {
+			return identityProviders, nil
+		}
+		identityProviders = append(identityProviders, *idpList.IdentityProviders...)
+		offset += int32(len(*idpList.IdentityProviders))
+		// A short page is the last one, even when the total is not reported
+		if len(*idpList.IdentityProviders) < int(limit) || (idpList.TotalResults != nil && int(offset) >= *idpList.TotalResults) {
+			return identityProviders, nil
+		}
+	}
+}
+
+func (r *authenticatorResolver) resolve(option AuthenticatorModel) LoginFlowOptionDetailsModel {
+	details := LoginFlowOptionDetailsModel{
+		Authenticator: option.Authenticator,
+		Idp:           option.Idp,
+		IsLocal:       option.Idp == "" || option.Idp == "LOCAL",
+	}
+
+	if details.IsLocal {
+		if localAuthenticator, ok := r.localAuthenticators[strings.ToLower(option.Authenticator)]; ok {
+			if localAuthenticator.Id != nil {
+				details.AuthenticatorId = *localAuthenticator.Id
+			}
+			if localAuthenticator.DisplayName != nil {
+				details.AuthenticatorDisplayName = *localAuthenticator.DisplayName
+			}
+		} else {
+			// Local authenticator IDs are the base64url encoded authenticator names
+			details.AuthenticatorId = base64.RawURLEncoding.EncodeToString([]byte(option.Authenticator))
+		}
+		return details
+	}
+
+	if federatedAuthenticator, ok := r.federatedAuthenticators[option.Idp]; ok && federatedAuthenticator.DisplayName != nil {
+		details.AuthenticatorDisplayName = *federatedAuthenticator.DisplayName
+	}
+	idp, ok := r.identityProviders[option.Idp]
+	if !ok {
+		return details
+	}
+	details.IdpId = idp.id
+	if authenticatorId, ok := idp.authenticatorIds[option.Authenticator]; ok {
+		details.AuthenticatorId = authenticatorId
+	} else if option.Authenticator == "" {
+		details.AuthenticatorId = idp.defaultAuthenticatorId
+	}
+	return details
+}
+
+func convertConfiguredAuthenticatorsToLoginFlowSteps(configuredAuthenticators *[]ConfiguredAuthenticatorsModel) []LoginFlowStepModel {
+	steps := []LoginFlowStepModel{}
+	if configuredAuthenticators == nil {
+		return steps
+	}
+	for _, configuredStep := range *configuredAuthenticators {
+		step := LoginFlowStepModel{Options: []AuthenticatorModel{}}
+		if configuredStep.StepId != nil {
+			step.Id = *configuredStep.StepId
+		}
+		if configuredStep.LocalAuthenticators != nil {
+			for _, localAuthenticator := range *configuredStep.LocalAuthenticators {
+				if localAuthenticator.Name == nil {
+					continue
+				}
+				step.Options = append(step.Options, AuthenticatorModel{
+					Authenticator: *localAuthenticator.Name,
+					Idp:           "LOCAL",
+				})
+			}
+		}
+		if configuredStep.FederatedAuthenticators != nil {
+			for _, federatedAuthenticator := range *configuredStep.FederatedAuthenticators {
+				if federatedAuthenticator.Name == nil {
+					continue
+				}
+				option := AuthenticatorModel{Idp: *federatedAuthenticator.Name}
+				if federatedAuthenticator.Type != nil {
+					option.Authenticator = *federatedAuthenticator.Type
+				}
+				step.Options = append(step.Options, option)
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
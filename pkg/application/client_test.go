@@ -20,11 +20,20 @@ package application
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/asgardeo/go/pkg/api_resource"
 	"github.com/asgardeo/go/pkg/application/internal"
 	"github.com/asgardeo/go/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -102,3 +111,135 @@ func TestListApplications(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Len(t, *resp.Applications, 2)
 }
+
+func TestUpdateCertificateFromPEM(t *testing.T) {
+	// Create a self-signed certificate expiring within the default warning window
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-app"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(7 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/server/v1/applications/app-id-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body internal.ApplicationPatchModel
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NotNil(t, body.AdvancedConfigurations)
+		require.NotNil(t, body.AdvancedConfigurations.Certificate)
+		assert.Equal(t, "PEM", *body.AdvancedConfigurations.Certificate.Type)
+		assert.Equal(t, string(pemData), *body.AdvancedConfigurations.Certificate.Value)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultClientConfig().
+		WithBaseURL(server.URL).
+		WithToken("test-token")
+
+	client, err := New(cfg)
+	require.NoError(t, err)
+
+	result, err := client.UpdateCertificateFromPEM(context.Background(), "app-id-1", pemData, nil)
+	require.NoError(t, err)
+	assert.Equal(t, CertificateTypePEM, result.Certificate.Type)
+	assert.Equal(t, "CN=test-app", result.Certificate.Subject)
+	assert.Len(t, result.Warnings, 1)
+
+	// An expired certificate must be rejected before any request is made
+	template.NotAfter = time.Now().Add(-time.Minute)
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	_, err = client.UpdateCertificateFromDER(context.Background(), "app-id-1", der, nil)
+	assert.Error(t, err)
+}
+
+// newTestServer serves the given handlers keyed by "METHOD path" and fails the test on any other request
+func newTestServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *ApplicationClient {
+	t.Helper()
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if text, ok := body.(string); ok {
+		_, _ = w.Write([]byte(text))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestAuthorizeAPIResourceCollection(t *testing.T) {
+	var authorized []AuthorizedAPICreateModel
+	var patched internal.AuthorizedAPIPatchModel
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/meta/api-resource-collections/applications": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "applications", "name": "applications", "displayName": "Application Management",
+				"apiResources": {"read": [
+					{"id": "apps-api", "name": "Application Management API", "self": "",
+						"scopes": [{"id": "1", "name": "internal_application_mgt_view", "displayName": "View"}]},
+					{"id": "templates-api", "name": "Application Template API", "self": "",
+						"scopes": [{"id": "2", "name": "internal_application_template_view", "displayName": "View"}]},
+					{"id": "claims-api", "name": "Claim Management API", "self": "",
+						"scopes": [{"id": "3", "name": "internal_claim_meta_view", "displayName": "View"}]}
+				]}}`)
+		},
+		"GET /api/server/v1/applications/app-id/authorized-apis": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[
+				{"id": "apps-api", "authorizedScopes": [{"name": "internal_application_mgt_update"}]},
+				{"id": "claims-api", "authorizedScopes": [{"name": "internal_claim_meta_view"}]}
+			]`)
+		},
+		"POST /api/server/v1/applications/app-id/authorized-apis": func(w http.ResponseWriter, r *http.Request) {
+			var authorization AuthorizedAPICreateModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&authorization))
+			authorized = append(authorized, authorization)
+			w.WriteHeader(http.StatusOK)
+		},
+		"PATCH /api/server/v1/applications/app-id/authorized-apis/apps-api": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	client := newTestClient(t, server)
+
+	err := client.AuthorizeAPIResourceCollection(context.Background(), "app-id", "applications", api_resource.CollectionAccessRead)
+	require.NoError(t, err)
+
+	// The API that is not yet authorized gets a new authorization
+	require.Len(t, authorized, 1)
+	assert.Equal(t, "templates-api", *authorized[0].Id)
+	assert.Equal(t, "RBAC", *authorized[0].PolicyIdentifier)
+	assert.Equal(t, []string{"internal_application_template_view"}, *authorized[0].Scopes)
+
+	// The already authorized API only gets the missing scope, and the fully authorized API is left untouched
+	require.NotNil(t, patched.AddedScopes)
+	assert.Equal(t, []string{"internal_application_mgt_view"}, *patched.AddedScopes)
+	assert.Nil(t, patched.RemovedScopes)
+}
@@ -0,0 +1,448 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/api_resource"
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/asgardeo/go/pkg/claim"
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/asgardeo/go/pkg/identity_provider"
+	"github.com/asgardeo/go/pkg/role"
+)
+
+const identityProviderPageSize int32 = 100
+
+// Clone creates a copy of an application with its OIDC configuration, claim configuration, login flow,
+// authorized APIs and roles. When overrides.TargetConfig is set, the application is cloned into that tenant
+// and claims, identity providers, APIs and roles are remapped by name instead of by ID.
+func (c *ApplicationClient) Clone(ctx context.Context, sourceId string, newName string, overrides *ApplicationCloneOverridesModel) (*ApplicationCloneResultModel, error) {
+	if overrides == nil {
+		overrides = &ApplicationCloneOverridesModel{}
+	}
+
+	target := c
+	crossTenant := overrides.TargetConfig != nil && overrides.TargetConfig != c.config
+	if crossTenant {
+		var err error
+		target, err = New(overrides.TargetConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create target application client: %w", err)
+		}
+	}
+
+	source, err := c.fetchApplicationDetails(ctx, sourceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone application: %w", err)
+	}
+	oauthDetails, err := c.fetchInboundOAuthDetails(ctx, sourceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone application: %w", err)
+	}
+	authorizedAPIs, err := c.GetAuthorizedAPIs(ctx, sourceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone application: %w", err)
+	}
+
+	result := &ApplicationCloneResultModel{}
+	appModel := internal.ApplicationModel{
+		Name:                   newName,
+		Description:            source.Description,
+		ImageUrl:               source.ImageUrl,
+		AccessUrl:              source.AccessUrl,
+		LogoutReturnUrl:        source.LogoutReturnUrl,
+		TemplateId:             source.TemplateId,
+		TemplateVersion:        source.TemplateVersion,
+		AdvancedConfigurations: source.AdvancedConfigurations,
+		ClaimConfiguration:     cloneClaimConfiguration(source.ClaimConfiguration),
+		AuthenticationSequence: source.AuthenticationSequence,
+		InboundProtocolConfiguration: &internal.InboundProtocols{
+			Oidc: cloneOIDCConfiguration(oauthDetails),
+		},
+	}
+	if appModel.AdvancedConfigurations != nil {
+		// Additional properties are read-only and rejected on creation
+		appModel.AdvancedConfigurations.AdditionalSpProperties = nil
+	}
+
+	applyCloneOverrides(&appModel, overrides)
+
+	if crossTenant {
+		if err := validateClonedClaims(ctx, overrides.TargetConfig, appModel.ClaimConfiguration); err != nil {
+			return nil, fmt.Errorf("failed to clone application: %w", err)
+		}
+		if err := validateClonedIdentityProviders(ctx, overrides.TargetConfig, appModel.AuthenticationSequence); err != nil {
+			return nil, fmt.Errorf("failed to clone application: %w", err)
+		}
+	}
+
+	associatedRoles, warnings, err := c.cloneAssociatedRoles(ctx, source.AssociatedRoles, overrides.TargetConfig, crossTenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone application: %w", err)
+	}
+	appModel.AssociatedRoles = associatedRoles
+	result.Warnings = append(result.Warnings, warnings...)
+
+	resp, err := target.apiClient.CreateApplicationWithResponse(ctx, nil, appModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone application: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return nil, fmt.Errorf("failed to clone application: status %d, body: %s",
+			resp.StatusCode(), string(resp.Body))
+	}
+	if resp.HTTPResponse == nil || resp.HTTPResponse.Header.Get("Location") == "" {
+		return nil, fmt.Errorf("location header is missing in the response")
+	}
+	appId, err := extractApplicationID(resp.HTTPResponse.Header.Get("Location"))
+	if err != nil {
+		return nil, err
+	}
+
+	if authorizedAPIs != nil {
+		for _, authorizedAPI := range *authorizedAPIs {
+			apiAuthorization, err := buildClonedAPIAuthorization(ctx, authorizedAPI, overrides.TargetConfig, crossTenant)
+			if err != nil {
+				return nil, fmt.Errorf("cloned application '%s' but failed to authorize APIs: %w", appId, err)
+			}
+			if err := target.AuthorizeAPI(ctx, appId, apiAuthorization); err != nil {
+				return nil, fmt.Errorf("cloned application '%s' but failed to authorize APIs: %w", appId, err)
+			}
+		}
+	}
+
+	appInfo, err := target.getClonedApplicationInfo(ctx, appId, newName)
+	if err != nil {
+		return nil, fmt.Errorf("cloned application '%s' but failed to fetch details: %w", appId, err)
+	}
+	result.Application = appInfo
+	return result, nil
+}
+
+func (c *ApplicationClient) getClonedApplicationInfo(ctx context.Context, appId string, name string) (*ApplicationBasicInfoResponseModel, error) {
+	appDetails, err := c.fetchApplicationDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := determineAppType(appDetails); err == nil {
+		return c.getApplicationDetails(ctx, appId)
+	}
+
+	// Applications created from custom templates only carry their identifiers
+	oauthDetails, err := c.fetchInboundOAuthDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	result := &ApplicationBasicInfoResponseModel{
+		Id:   appId,
+		Name: name,
+	}
+	if oauthDetails.ClientId != nil {
+		result.ClientId = *oauthDetails.ClientId
+	}
+	if oauthDetails.ClientSecret != nil {
+		result.ClientSecret = *oauthDetails.ClientSecret
+	}
+	return result, nil
+}
+
+// cloneOIDCConfiguration copies an OIDC configuration without the client credentials so new ones are issued
+func cloneOIDCConfiguration(oauthDetails *internal.OpenIDConnectConfiguration) *internal.OpenIDConnectConfiguration {
+	if oauthDetails == nil {
+		return nil
+	}
+	cloned := *oauthDetails
+	cloned.ClientId = nil
+	cloned.ClientSecret = nil
+	cloned.State = nil
+	return &cloned
+}
+
+// cloneClaimConfiguration copies a claim configuration with claims referenced only by URI
+func cloneClaimConfiguration(claimConfig *internal.ClaimConfiguration) *internal.ClaimConfiguration {
+	if claimConfig == nil {
+		return nil
+	}
+	cloned := *claimConfig
+	if claimConfig.RequestedClaims != nil {
+		requestedClaims := make([]internal.RequestedClaimConfiguration, len(*claimConfig.RequestedClaims))
+		for i, requestedClaim := range *claimConfig.RequestedClaims {
+			requestedClaims[i] = requestedClaim
+			requestedClaims[i].Claim = internal.Claim{Uri: requestedClaim.Claim.Uri}
+		}
+		cloned.RequestedClaims = &requestedClaims
+	}
+	if claimConfig.ClaimMappings != nil {
+		claimMappings := make([]internal.ClaimMappings, len(*claimConfig.ClaimMappings))
+		for i, claimMapping := range *claimConfig.ClaimMappings {
+			claimMappings[i] = internal.ClaimMappings{
+				ApplicationClaim: claimMapping.ApplicationClaim,
+				LocalClaim:       internal.Claim{Uri: claimMapping.LocalClaim.Uri},
+			}
+		}
+		cloned.ClaimMappings = &claimMappings
+	}
+	if claimConfig.Subject != nil && claimConfig.Subject.Claim != nil {
+		subject := *claimConfig.Subject
+		subject.Claim = &internal.Claim{Uri: claimConfig.Subject.Claim.Uri}
+		cloned.Subject = &subject
+	}
+	if claimConfig.Role != nil && claimConfig.Role.Claim != nil {
+		role := *claimConfig.Role
+		role.Claim = &internal.Claim{Uri: claimConfig.Role.Claim.Uri}
+		cloned.Role = &role
+	}
+	return &cloned
+}
+
+func applyCloneOverrides(appModel *internal.ApplicationModel, overrides *ApplicationCloneOverridesModel) {
+	if overrides.Description != nil {
+		appModel.Description = overrides.Description
+	}
+	if overrides.ImageUrl != nil {
+		appModel.ImageUrl = overrides.ImageUrl
+	}
+	if overrides.AccessUrl != nil {
+		appModel.AccessUrl = overrides.AccessUrl
+	}
+	if overrides.LogoutReturnUrl != nil {
+		appModel.LogoutReturnUrl = overrides.LogoutReturnUrl
+	}
+
+	oidc := appModel.InboundProtocolConfiguration.Oidc
+	if oidc == nil {
+		return
+	}
+	if overrides.CallbackURLs != nil {
+		callbackURLs := buildCallbackURLs(*overrides.CallbackURLs)
+		oidc.CallbackURLs = &callbackURLs
+	}
+	if overrides.AllowedOrigins != nil {
+		oidc.AllowedOrigins = overrides.AllowedOrigins
+	}
+}
+
+// validateClonedClaims ensures all local claims referenced by the claim configuration exist in the target tenant
+func validateClonedClaims(ctx context.Context, targetConfig *config.ClientConfig, claimConfig *internal.ClaimConfiguration) error {
+	if claimConfig == nil {
+		return nil
+	}
+
+	var claimURIs []string
+	if claimConfig.RequestedClaims != nil && (claimConfig.Dialect == nil || *claimConfig.Dialect == internal.LOCAL) {
+		for _, requestedClaim := range *claimConfig.RequestedClaims {
+			claimURIs = append(claimURIs, requestedClaim.Claim.Uri)
+		}
+	}
+	if claimConfig.ClaimMappings != nil {
+		for _, claimMapping := range *claimConfig.ClaimMappings {
+			claimURIs = append(claimURIs, claimMapping.LocalClaim.Uri)
+		}
+	}
+	if claimConfig.Subject != nil && claimConfig.Subject.Claim != nil {
+		claimURIs = append(claimURIs, claimConfig.Subject.Claim.Uri)
+	}
+	if claimConfig.Role != nil && claimConfig.Role.Claim != nil {
+		claimURIs = append(claimURIs, claimConfig.Role.Claim.Uri)
+	}
+	if len(claimURIs) == 0 {
+		return nil
+	}
+
+	claimClient, err := claim.New(targetConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create claim client: %w", err)
+	}
+	missingClaimURIs, err := claimClient.FindMissingLocalClaims(ctx, claimURIs)
+	if err != nil {
+		return fmt.Errorf("failed to list local claims of target tenant: %w", err)
+	}
+	if len(missingClaimURIs) > 0 {
+		return fmt.Errorf("local claims not found in target tenant: %s", strings.Join(missingClaimURIs, ", "))
+	}
+	return nil
+}
+
+// validateClonedIdentityProviders ensures all identity providers referenced by the login flow exist in the target tenant
+func validateClonedIdentityProviders(ctx context.Context, targetConfig *config.ClientConfig, loginFlow *internal.AuthenticationSequence) error {
+	if loginFlow == nil || loginFlow.Steps == nil {
+		return nil
+	}
+
+	idpNames := make(map[string]struct{})
+	for _, step := range *loginFlow.Steps {
+		for _, option := range step.Options {
+			if option.Idp != "" && option.Idp != "LOCAL" {
+				idpNames[option.Idp] = struct{}{}
+			}
+		}
+	}
+	if len(idpNames) == 0 {
+		return nil
+	}
+
+	identityProviderClient, err := identity_provider.New(targetConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create identity provider client: %w", err)
+	}
+	identityProviders, err := listAllIdentityProviders(ctx, identityProviderClient, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list identity providers of target tenant: %w", err)
+	}
+	for _, idp := range identityProviders {
+		if idp.Name != nil {
+			delete(idpNames, *idp.Name)
+		}
+	}
+
+	if len(idpNames) > 0 {
+		missingIdpNames := make([]string, 0, len(idpNames))
+		for idpName := range idpNames {
+			missingIdpNames = append(missingIdpNames, idpName)
+		}
+		sort.Strings(missingIdpNames)
+		return fmt.Errorf("identity providers not found in target tenant: %s", strings.Join(missingIdpNames, ", "))
+	}
+	return nil
+}
+
+// cloneAssociatedRoles copies organization roles, remapping them by name in the target tenant.
+// Application audience roles belong to the source application and are reported as warnings.
+func (c *ApplicationClient) cloneAssociatedRoles(ctx context.Context, associatedRoles *internal.AssociatedRolesConfig, targetConfig *config.ClientConfig, crossTenant bool) (*internal.AssociatedRolesConfig, []string, error) {
+	cloned := &internal.AssociatedRolesConfig{
+		AllowedAudience: internal.APPLICATION,
+		Roles:           &[]internal.Role{},
+	}
+	if associatedRoles == nil {
+		return cloned, nil, nil
+	}
+	cloned.AllowedAudience = associatedRoles.AllowedAudience
+	if associatedRoles.Roles == nil || len(*associatedRoles.Roles) == 0 {
+		return cloned, nil, nil
+	}
+
+	var warnings []string
+	if associatedRoles.AllowedAudience == internal.APPLICATION {
+		for _, role := range *associatedRoles.Roles {
+			warnings = append(warnings, fmt.Sprintf("application role '%s' was not cloned: application roles belong to the source application", roleName(role)))
+		}
+		return cloned, warnings, nil
+	}
+
+	if !crossTenant {
+		cloned.Roles = associatedRoles.Roles
+		return cloned, nil, nil
+	}
+
+	roles := make([]internal.Role, 0, len(*associatedRoles.Roles))
+	for _, role := range *associatedRoles.Roles {
+		if role.Name == nil {
+			warnings = append(warnings, fmt.Sprintf("organization role '%s' was not cloned: role name is not available", role.Id))
+			continue
+		}
+		roleId, err := findOrganizationRoleId(ctx, targetConfig, *role.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if roleId == "" {
+			warnings = append(warnings, fmt.Sprintf("organization role '%s' was not cloned: role not found in target tenant", *role.Name))
+			continue
+		}
+		roles = append(roles, internal.Role{Id: roleId, Name: role.Name})
+	}
+	cloned.Roles = &roles
+	return cloned, warnings, nil
+}
+
+// buildClonedAPIAuthorization builds an API authorization for a cloned application, resolving the API
+// by its identifier when cloning into another tenant
+func buildClonedAPIAuthorization(ctx context.Context, authorizedAPI AuthorizedAPIResponseModel, targetConfig *config.ClientConfig, crossTenant bool) (AuthorizedAPICreateModel, error) {
+	scopes := []string{}
+	if authorizedAPI.AuthorizedScopes != nil {
+		for _, scope := range *authorizedAPI.AuthorizedScopes {
+			if scope.Name != nil {
+				scopes = append(scopes, *scope.Name)
+			}
+		}
+	}
+	apiAuthorization := AuthorizedAPICreateModel{
+		Id:               authorizedAPI.Id,
+		PolicyIdentifier: authorizedAPI.PolicyId,
+		Scopes:           &scopes,
+	}
+	if authorizedAPI.AuthorizedAuthorizationDetailsTypes != nil {
+		detailsTypes := make([]string, 0, len(*authorizedAPI.AuthorizedAuthorizationDetailsTypes))
+		for _, detailsType := range *authorizedAPI.AuthorizedAuthorizationDetailsTypes {
+			detailsTypes = append(detailsTypes, detailsType.Type)
+		}
+		apiAuthorization.AuthorizationDetailsTypes = &detailsTypes
+	}
+
+	if !crossTenant {
+		return apiAuthorization, nil
+	}
+	if authorizedAPI.Identifier == nil {
+		return apiAuthorization, fmt.Errorf("identifier of authorized API '%s' is not available", stringValue(authorizedAPI.Id))
+	}
+
+	apiResourceClient, err := api_resource.New(targetConfig)
+	if err != nil {
+		return apiAuthorization, fmt.Errorf("failed to create api resource client: %w", err)
+	}
+	apiResource, err := apiResourceClient.GetByIdentifier(ctx, *authorizedAPI.Identifier)
+	if err != nil {
+		return apiAuthorization, err
+	}
+	apiAuthorization.Id = &apiResource.Id
+	return apiAuthorization, nil
+}
+
+// findOrganizationRoleId looks up an organization audience role by name.
+// Returns an empty ID if no matching role exists.
+func findOrganizationRoleId(ctx context.Context, cfg *config.ClientConfig, name string) (string, error) {
+	roleClient, err := role.New(cfg)
+	if err != nil {
+		return "", err
+	}
+	organizationRole, err := roleClient.FindOrganizationRole(ctx, name)
+	if err != nil || organizationRole == nil {
+		return "", err
+	}
+	return organizationRole.Id, nil
+}
+
+func roleName(role internal.Role) string {
+	if role.Name != nil {
+		return *role.Name
+	}
+	return role.Id
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cloneSourceApplication = `{
+		"id": "src-app",
+		"name": "Pickup",
+		"description": "Pickup dashboard",
+		"claimConfiguration": {
+			"dialect": "LOCAL",
+			"requestedClaims": [{"claim": {"uri": "http://wso2.org/claims/emailaddress", "displayName": "Email"}, "mandatory": true}]
+		},
+		"authenticationSequence": {"type": "USER_DEFINED", "steps": [
+			{"id": 1, "options": [{"idp": "LOCAL", "authenticator": "BasicAuthenticator"}, {"idp": "Google", "authenticator": "GoogleOIDCAuthenticator"}]}
+		]},
+		"associatedRoles": {"allowedAudience": "ORGANIZATION", "roles": [{"id": "src-manager", "name": "Manager"}, {"id": "src-auditor", "name": "Auditor"}]}
+	}`
+	cloneSourceOIDC = `{
+		"clientId": "src-client-id",
+		"clientSecret": "src-client-secret",
+		"grantTypes": ["authorization_code"],
+		"callbackURLs": ["https://pickup.example.com/callback"],
+		"allowedOrigins": ["https://pickup.example.com"]
+	}`
+	cloneSourceAuthorizedAPIs = `[{"id": "src-api", "identifier": "https://api.pickup.example.com", "policyId": "RBAC",
+		"authorizedScopes": [{"name": "read_orders"}]}]`
+	clonedApplicationLocation = "https://localhost/api/server/v1/applications/cloned-app"
+)
+
+// cloneSourceRoutes serves the source application
+func cloneSourceRoutes() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/src-app": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, cloneSourceApplication)
+		},
+		"GET /api/server/v1/applications/src-app/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, cloneSourceOIDC)
+		},
+		"GET /api/server/v1/applications/src-app/authorized-apis": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, cloneSourceAuthorizedAPIs)
+		},
+	}
+}
+
+// clonedApplicationRoutes accepts the created application and its API authorizations
+func clonedApplicationRoutes(t *testing.T, created *internal.ApplicationModel, authorized *[]AuthorizedAPICreateModel) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"POST /api/server/v1/applications": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			w.Header().Set("Location", clonedApplicationLocation)
+			w.WriteHeader(http.StatusCreated)
+		},
+		"POST /api/server/v1/applications/cloned-app/authorized-apis": func(w http.ResponseWriter, r *http.Request) {
+			var authorization AuthorizedAPICreateModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&authorization))
+			*authorized = append(*authorized, authorization)
+			w.WriteHeader(http.StatusOK)
+		},
+		"GET /api/server/v1/applications/cloned-app": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "cloned-app", "name": "Pickup Staging"}`)
+		},
+		"GET /api/server/v1/applications/cloned-app/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"clientId": "new-client-id", "clientSecret": "new-client-secret"}`)
+		},
+	}
+}
+
+func mergeRoutes(routes ...map[string]http.HandlerFunc) map[string]http.HandlerFunc {
+	merged := map[string]http.HandlerFunc{}
+	for _, r := range routes {
+		for key, handler := range r {
+			merged[key] = handler
+		}
+	}
+	return merged
+}
+
+func TestCloneWithinTenant(t *testing.T) {
+	var created internal.ApplicationModel
+	var authorized []AuthorizedAPICreateModel
+	server := newTestServer(t, mergeRoutes(cloneSourceRoutes(), clonedApplicationRoutes(t, &created, &authorized)))
+	client := newTestClient(t, server)
+
+	callbackURLs := []string{"https://staging.pickup.example.com/callback"}
+	result, err := client.Clone(context.Background(), "src-app", "Pickup Staging", &ApplicationCloneOverridesModel{
+		CallbackURLs: &callbackURLs,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "cloned-app", result.Application.Id)
+	assert.Equal(t, "new-client-id", result.Application.ClientId)
+	assert.Empty(t, result.Warnings)
+
+	assert.Equal(t, "Pickup Staging", created.Name)
+	assert.Equal(t, "Pickup dashboard", *created.Description)
+	oidc := created.InboundProtocolConfiguration.Oidc
+	require.NotNil(t, oidc)
+	assert.Nil(t, oidc.ClientId, "client credentials must not be copied")
+	assert.Nil(t, oidc.ClientSecret, "client credentials must not be copied")
+	assert.Equal(t, []string{"https://staging.pickup.example.com/callback"}, *oidc.CallbackURLs)
+	// Roles keep their IDs within the same tenant
+	assert.Equal(t, []internal.Role{{Id: "src-manager", Name: stringPtr("Manager")}, {Id: "src-auditor", Name: stringPtr("Auditor")}},
+		*created.AssociatedRoles.Roles)
+
+	require.Len(t, authorized, 1)
+	assert.Equal(t, "src-api", *authorized[0].Id)
+	assert.Equal(t, []string{"read_orders"}, *authorized[0].Scopes)
+}
+
+// crossTenantRoutes serves the target tenant lookups used when cloning across tenants
+func crossTenantRoutes(t *testing.T, localClaims string, identityProviders string) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/claim-dialects/local/claims": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, localClaims)
+		},
+		"GET /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, identityProviders)
+		},
+		"GET /scim2/v2/Roles": func(w http.ResponseWriter, r *http.Request) {
+			filter := r.URL.Query().Get("filter")
+			switch filter {
+			case `displayName eq "Manager"`:
+				writeJSON(w, http.StatusOK, `{"Resources": [
+					{"id": "app-manager", "displayName": "Manager", "audience": {"type": "application"}},
+					{"id": "target-manager", "displayName": "Manager", "audience": {"type": "organization"}}]}`)
+			case `displayName eq "Auditor"`:
+				writeJSON(w, http.StatusOK, `{"totalResults": 0}`)
+			default:
+				t.Errorf("unexpected role filter %s", filter)
+			}
+		},
+		"GET /api/server/v1/api-resources": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `identifier eq "https://api.pickup.example.com"`, r.URL.Query().Get("filter"))
+			writeJSON(w, http.StatusOK, `{"apiResources": [{"id": "target-api", "identifier": "https://api.pickup.example.com", "name": "Orders"}]}`)
+		},
+	}
+}
+
+func TestCloneAcrossTenants(t *testing.T) {
+	sourceServer := newTestServer(t, cloneSourceRoutes())
+	source := newTestClient(t, sourceServer)
+
+	var created internal.ApplicationModel
+	var authorized []AuthorizedAPICreateModel
+	targetServer := newTestServer(t, mergeRoutes(
+		clonedApplicationRoutes(t, &created, &authorized),
+		crossTenantRoutes(t, `[{"claimURI": "http://wso2.org/claims/emailaddress"}]`,
+			`{"totalResults": 1, "identityProviders": [{"id": "target-google", "name": "Google"}]}`),
+	))
+	target := newTestClient(t, targetServer)
+
+	result, err := source.Clone(context.Background(), "src-app", "Pickup Staging", &ApplicationCloneOverridesModel{
+		TargetConfig: target.config,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "cloned-app", result.Application.Id)
+	// Organization roles are remapped by name, and missing roles are reported
+	assert.Equal(t, []internal.Role{{Id: "target-manager", Name: stringPtr("Manager")}}, *created.AssociatedRoles.Roles)
+	assert.Equal(t, []string{"organization role 'Auditor' was not cloned: role not found in target tenant"}, result.Warnings)
+	// APIs are remapped by identifier
+	require.Len(t, authorized, 1)
+	assert.Equal(t, "target-api", *authorized[0].Id)
+}
+
+func TestCloneAcrossTenantsRejectsMissingReferences(t *testing.T) {
+	tests := []struct {
+		name              string
+		localClaims       string
+		identityProviders string
+		errMsg            string
+	}{
+		{
+			name:              "missing claim",
+			localClaims:       `[{"claimURI": "http://wso2.org/claims/username"}]`,
+			identityProviders: `{"totalResults": 1, "identityProviders": [{"id": "target-google", "name": "Google"}]}`,
+			errMsg:            "local claims not found in target tenant: http://wso2.org/claims/emailaddress",
+		},
+		{
+			name:              "missing identity provider",
+			localClaims:       `[{"claimURI": "http://wso2.org/claims/emailaddress"}]`,
+			identityProviders: `{"totalResults": 1, "identityProviders": [{"id": "target-github", "name": "GitHub"}]}`,
+			errMsg:            "identity providers not found in target tenant: Google",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newTestClient(t, newTestServer(t, cloneSourceRoutes()))
+			targetRoutes := crossTenantRoutes(t, tt.localClaims, tt.identityProviders)
+			targetRoutes["POST /api/server/v1/applications"] = func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(io.Discard, r.Body)
+				t.Error("application must not be created when references are missing")
+				w.WriteHeader(http.StatusCreated)
+			}
+			target := newTestClient(t, newTestServer(t, targetRoutes))
+
+			_, err := source.Clone(context.Background(), "src-app", "Pickup Staging", &ApplicationCloneOverridesModel{
+				TargetConfig: target.config,
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/asgardeo/go/pkg/common"
+)
+
+// EnsureSinglePageApp creates a Single Page Application if none exists with the given name,
+// otherwise updates only the fields that differ from the desired state.
+func (c *ApplicationClient) EnsureSinglePageApp(ctx context.Context, name string, redirectURL string, opts *ApplicationEnsureOptionsModel) (*ApplicationEnsureResultModel, error) {
+	return c.ensureApplication(ctx, name, AppTypeSPA, &redirectURL, opts, func() (*ApplicationBasicInfoResponseModel, error) {
+		return c.CreateSinglePageApp(ctx, name, redirectURL)
+	})
+}
+
+// EnsureMobileApp creates a Mobile Application if none exists with the given name,
+// otherwise updates only the fields that differ from the desired state.
+func (c *ApplicationClient) EnsureMobileApp(ctx context.Context, name string, redirectURL string, opts *ApplicationEnsureOptionsModel) (*ApplicationEnsureResultModel, error) {
+	return c.ensureApplication(ctx, name, AppTypeMobile, &redirectURL, opts, func() (*ApplicationBasicInfoResponseModel, error) {
+		return c.CreateMobileApp(ctx, name, redirectURL)
+	})
+}
+
+// EnsureM2MApp creates a Machine-to-Machine (M2M) Application if none exists with the given name,
+// otherwise updates only the fields that differ from the desired state.
+func (c *ApplicationClient) EnsureM2MApp(ctx context.Context, name string, opts *ApplicationEnsureOptionsModel) (*ApplicationEnsureResultModel, error) {
+	return c.ensureApplication(ctx, name, AppTypeM2M, nil, opts, func() (*ApplicationBasicInfoResponseModel, error) {
+		return c.CreateM2MApp(ctx, name)
+	})
+}
+
+// EnsureWebAppWithSSR creates a Web Application with Server-Side Rendering support if none exists with the given name,
+// otherwise updates only the fields that differ from the desired state.
+func (c *ApplicationClient) EnsureWebAppWithSSR(ctx context.Context, name string, redirectURL string, opts *ApplicationEnsureOptionsModel) (*ApplicationEnsureResultModel, error) {
+	return c.ensureApplication(ctx, name, AppTypeSSRWeb, &redirectURL, opts, func() (*ApplicationBasicInfoResponseModel, error) {
+		return c.CreateWebAppWithSSR(ctx, name, redirectURL)
+	})
+}
+
+func (c *ApplicationClient) ensureApplication(ctx context.Context, name string, appType AppType, redirectURL *string, opts *ApplicationEnsureOptionsModel,
+	create func() (*ApplicationBasicInfoResponseModel, error)) (*ApplicationEnsureResultModel, error) {
+	if opts == nil {
+		opts = &ApplicationEnsureOptionsModel{}
+	}
+
+	existingApp, err := c.findApplicationByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	action := common.EnsureActionUnchanged
+	var appId string
+	if existingApp == nil {
+		// Options that the created application does not already satisfy are applied through the update path below
+		created, err := create()
+		if err != nil {
+			return nil, err
+		}
+		action = common.EnsureActionCreated
+		appId = created.Id
+	} else {
+		appId = *existingApp.Id
+	}
+
+	appDetails, err := c.fetchApplicationDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	existingType, err := determineAppType(appDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the type of application '%s': %w", name, err)
+	}
+	if existingType != appType {
+		if action == common.EnsureActionCreated {
+			return nil, fmt.Errorf("created application '%s' is of type %s instead of %s", name, existingType, appType)
+		}
+		return nil, fmt.Errorf("application '%s' already exists but is not of type %s", name, appType)
+	}
+
+	var changes []common.FieldChange
+
+	basicInfoUpdate := ApplicationBasicInfoUpdateModel{}
+	basicInfoChanged := false
+	if opts.BasicInfo != nil {
+		desired := opts.BasicInfo
+		if diffString(&changes, "description", appDetails.Description, desired.Description) {
+			basicInfoUpdate.Description = desired.Description
+			basicInfoChanged = true
+		}
+		if diffString(&changes, "imageUrl", appDetails.ImageUrl, desired.ImageUrl) {
+			basicInfoUpdate.ImageUrl = desired.ImageUrl
+			basicInfoChanged = true
+		}
+		if diffString(&changes, "accessUrl", appDetails.AccessUrl, desired.AccessUrl) {
+			basicInfoUpdate.AccessUrl = desired.AccessUrl
+			basicInfoChanged = true
+		}
+		if diffString(&changes, "logoutReturnUrl", appDetails.LogoutReturnUrl, desired.LogoutReturnUrl) {
+			basicInfoUpdate.LogoutReturnUrl = desired.LogoutReturnUrl
+			basicInfoChanged = true
+		}
+	}
+
+	desiredOAuth := ApplicationOAuthConfigUpdateModel{}
+	if opts.OAuthConfig != nil {
+		desiredOAuth = *opts.OAuthConfig
+	}
+	if redirectURL != nil && desiredOAuth.CallbackURLs == nil {
+		desiredOAuth.CallbackURLs = &[]string{*redirectURL}
+	}
+	if appType == AppTypeSPA && redirectURL != nil && desiredOAuth.AllowedOrigins == nil {
+		allowedOrigins, err := extractOrigins(*redirectURL)
+		if err != nil {
+			return nil, err
+		}
+		desiredOAuth.AllowedOrigins = &allowedOrigins
+	}
+
+	oauthDetails, err := c.fetchInboundOAuthDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthUpdate := ApplicationOAuthConfigUpdateModel{}
+	oauthChanged := false
+	if desiredOAuth.CallbackURLs != nil {
+		var current []string
+		if oauthDetails.CallbackURLs != nil {
+			current = *oauthDetails.CallbackURLs
+		}
+		if !reflect.DeepEqual(current, buildCallbackURLs(*desiredOAuth.CallbackURLs)) {
+			changes = append(changes, common.FieldChange{Field: "callbackURLs", OldValue: current, NewValue: *desiredOAuth.CallbackURLs})
+			oauthUpdate.CallbackURLs = desiredOAuth.CallbackURLs
+			oauthChanged = true
+		}
+	}
+	if diffStringSet(&changes, "allowedOrigins", oauthDetails.AllowedOrigins, desiredOAuth.AllowedOrigins) {
+		oauthUpdate.AllowedOrigins = desiredOAuth.AllowedOrigins
+		oauthChanged = true
+	}
+
+	var currentAccessTokenAttributes *[]string
+	var currentApplicationAccessTokenExpiry, currentUserAccessTokenExpiry, currentRefreshTokenExpiry *int64
+	if oauthDetails.AccessToken != nil {
+		currentAccessTokenAttributes = oauthDetails.AccessToken.AccessTokenAttributes
+		currentApplicationAccessTokenExpiry = oauthDetails.AccessToken.ApplicationAccessTokenExpiryInSeconds
+		currentUserAccessTokenExpiry = oauthDetails.AccessToken.UserAccessTokenExpiryInSeconds
+	}
+	if oauthDetails.RefreshToken != nil {
+		currentRefreshTokenExpiry = oauthDetails.RefreshToken.ExpiryInSeconds
+	}
+	if diffStringSet(&changes, "accessTokenAttributes", currentAccessTokenAttributes, desiredOAuth.AccessTokenAttributes) {
+		oauthUpdate.AccessTokenAttributes = desiredOAuth.AccessTokenAttributes
+		oauthChanged = true
+	}
+	if diffInt64(&changes, "applicationAccessTokenExpiryInSeconds", currentApplicationAccessTokenExpiry, desiredOAuth.ApplicationAccessTokenExpiryInSeconds) {
+		oauthUpdate.ApplicationAccessTokenExpiryInSeconds = desiredOAuth.ApplicationAccessTokenExpiryInSeconds
+		oauthChanged = true
+	}
+	if diffInt64(&changes, "userAccessTokenExpiryInSeconds", currentUserAccessTokenExpiry, desiredOAuth.UserAccessTokenExpiryInSeconds) {
+		oauthUpdate.UserAccessTokenExpiryInSeconds = desiredOAuth.UserAccessTokenExpiryInSeconds
+		oauthChanged = true
+	}
+	if diffInt64(&changes, "refreshTokenExpiryInSeconds", currentRefreshTokenExpiry, desiredOAuth.RefreshTokenExpiryInSeconds) {
+		oauthUpdate.RefreshTokenExpiryInSeconds = desiredOAuth.RefreshTokenExpiryInSeconds
+		oauthChanged = true
+	}
+	if desiredOAuth.Logout != nil && !reflect.DeepEqual(oauthDetails.Logout, desiredOAuth.Logout) {
+		changes = append(changes, common.FieldChange{Field: "logout", OldValue: oauthDetails.Logout, NewValue: desiredOAuth.Logout})
+		oauthUpdate.Logout = desiredOAuth.Logout
+		oauthChanged = true
+	}
+
+	if basicInfoChanged {
+		if err := c.UpdateBasicInfo(ctx, appId, basicInfoUpdate); err != nil {
+			return nil, wrapCreatedApplicationError(action, "basic info", err)
+		}
+	}
+	if oauthChanged {
+		if err := c.UpdateOAuthConfig(ctx, appId, oauthUpdate); err != nil {
+			return nil, wrapCreatedApplicationError(action, "OAuth configuration", err)
+		}
+	}
+
+	app, err := c.getApplicationDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	if action == common.EnsureActionUnchanged && len(changes) > 0 {
+		action = common.EnsureActionUpdated
+	}
+	return &ApplicationEnsureResultModel{
+		Action:      action,
+		Application: app,
+		Changes:     changes,
+	}, nil
+}
+
+// wrapCreatedApplicationError reports that an application was created when applying its options failed
+func wrapCreatedApplicationError(action common.EnsureAction, options string, err error) error {
+	if action == common.EnsureActionCreated {
+		return fmt.Errorf("created application but failed to apply %s: %w", options, err)
+	}
+	return err
+}
+
+// diffString records a change if the desired value is set and differs from the current value
+func diffString(changes *[]common.FieldChange, field string, current *string, desired *string) bool {
+	if desired == nil || (current != nil && *current == *desired) {
+		return false
+	}
+	*changes = append(*changes, common.FieldChange{Field: field, OldValue: stringValue(current), NewValue: *desired})
+	return true
+}
+
+// diffInt64 records a change if the desired value is set and differs from the current value
+func diffInt64(changes *[]common.FieldChange, field string, current *int64, desired *int64) bool {
+	if desired == nil || (current != nil && *current == *desired) {
+		return false
+	}
+	var oldValue interface{}
+	if current != nil {
+		oldValue = *current
+	}
+	*changes = append(*changes, common.FieldChange{Field: field, OldValue: oldValue, NewValue: *desired})
+	return true
+}
+
+// diffStringSet records a change if the desired values are set and differ from the current values, ignoring order
+func diffStringSet(changes *[]common.FieldChange, field string, current *[]string, desired *[]string) bool {
+	if desired == nil {
+		return false
+	}
+	currentValues := []string{}
+	if current != nil {
+		currentValues = append(currentValues, *current...)
+	}
+	desiredValues := append([]string{}, *desired...)
+	sort.Strings(currentValues)
+	sort.Strings(desiredValues)
+	if reflect.DeepEqual(currentValues, desiredValues) {
+		return false
+	}
+	*changes = append(*changes, common.FieldChange{Field: field, OldValue: currentValues, NewValue: *desired})
+	return true
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/asgardeo/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeM2MApplication keeps the state of a single M2M application across requests
+type fakeM2MApplication struct {
+	exists      bool
+	templateId  *string
+	description *string
+	oauth       internal.OpenIDConnectConfiguration
+	creates     int
+	patches     int
+	oauthPuts   int
+}
+
+func (f *fakeM2MApplication) routes(t *testing.T) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `name eq "Orders Worker"`, r.URL.Query().Get("filter"))
+			applications := []internal.ApplicationListItem{}
+			if f.exists {
+				applications = append(applications, internal.ApplicationListItem{
+					Id:   stringPtr("m2m-app"),
+					Name: stringPtr("Orders Worker"),
+				})
+			}
+			writeJSON(w, http.StatusOK, internal.ApplicationListResponse{Applications: &applications})
+		},
+		"POST /api/server/v1/applications": func(w http.ResponseWriter, r *http.Request) {
+			f.creates++
+			f.exists = true
+			f.oauth = internal.OpenIDConnectConfiguration{
+				ClientId:     stringPtr("m2m-client-id"),
+				ClientSecret: stringPtr("m2m-client-secret"),
+			}
+			w.Header().Set("Location", "https://localhost/api/server/v1/applications/m2m-app")
+			w.WriteHeader(http.StatusCreated)
+		},
+		"GET /api/server/v1/applications/m2m-app": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, internal.ApplicationResponseModel{
+				Id:          stringPtr("m2m-app"),
+				Name:        "Orders Worker",
+				Description: f.description,
+				ClientId:    stringPtr("m2m-client-id"),
+				TemplateId:  f.template(),
+			})
+		},
+		"PATCH /api/server/v1/applications/m2m-app": func(w http.ResponseWriter, r *http.Request) {
+			f.patches++
+			var patch internal.ApplicationPatchModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			if patch.Description != nil {
+				f.description = patch.Description
+			}
+			w.WriteHeader(http.StatusOK)
+		},
+		"GET /api/server/v1/applications/m2m-app/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, f.oauth)
+		},
+		"PUT /api/server/v1/applications/m2m-app/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			f.oauthPuts++
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&f.oauth))
+			w.WriteHeader(http.StatusOK)
+		},
+		"GET /api/server/v1/applications/m2m-app/authorized-apis": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[]`)
+		},
+	}
+}
+
+func (f *fakeM2MApplication) template() *string {
+	if f.templateId != nil {
+		return f.templateId
+	}
+	return stringPtr("m2m-application")
+}
+
+func ensureM2MOptions() *ApplicationEnsureOptionsModel {
+	return &ApplicationEnsureOptionsModel{
+		BasicInfo: &ApplicationBasicInfoUpdateModel{
+			Description: stringPtr("Processes orders"),
+		},
+		OAuthConfig: &ApplicationOAuthConfigUpdateModel{
+			ApplicationAccessTokenExpiryInSeconds: int64Ptr(600),
+		},
+	}
+}
+
+func TestEnsureM2MAppCreatesAndAppliesOptions(t *testing.T) {
+	app := &fakeM2MApplication{}
+	client := newTestClient(t, newTestServer(t, app.routes(t)))
+
+	result, err := client.EnsureM2MApp(context.Background(), "Orders Worker", ensureM2MOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t, common.EnsureActionCreated, result.Action)
+	assert.Equal(t, 1, app.creates)
+	assert.Equal(t, 1, app.patches)
+	assert.Equal(t, 1, app.oauthPuts)
+	assert.ElementsMatch(t, []common.FieldChange{
+		{Field: "description", OldValue: "", NewValue: "Processes orders"},
+		{Field: "applicationAccessTokenExpiryInSeconds", OldValue: nil, NewValue: int64(600)},
+	}, result.Changes)
+
+	require.NotNil(t, result.Application)
+	assert.Equal(t, "m2m-app", result.Application.Id)
+	assert.Equal(t, "m2m-client-id", result.Application.ClientId)
+	assert.Equal(t, "m2m-client-secret", result.Application.ClientSecret)
+}
+
+func TestEnsureM2MAppUpdatesChangedFields(t *testing.T) {
+	app := &fakeM2MApplication{
+		exists:      true,
+		description: stringPtr("Old description"),
+		oauth: internal.OpenIDConnectConfiguration{
+			ClientId: stringPtr("m2m-client-id"),
+			AccessToken: &internal.AccessTokenConfiguration{
+				ApplicationAccessTokenExpiryInSeconds: int64Ptr(600),
+			},
+		},
+	}
+	client := newTestClient(t, newTestServer(t, app.routes(t)))
+
+	result, err := client.EnsureM2MApp(context.Background(), "Orders Worker", ensureM2MOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t, common.EnsureActionUpdated, result.Action)
+	assert.Equal(t, 0, app.creates)
+	assert.Equal(t, 1, app.patches)
+	assert.Equal(t, 0, app.oauthPuts, "unchanged OAuth configuration must not be written")
+	assert.Equal(t, []common.FieldChange{
+		{Field: "description", OldValue: "Old description", NewValue: "Processes orders"},
+	}, result.Changes)
+	assert.Equal(t, "Processes orders", *app.description)
+}
+
+func TestEnsureM2MAppIsIdempotent(t *testing.T) {
+	app := &fakeM2MApplication{}
+	client := newTestClient(t, newTestServer(t, app.routes(t)))
+
+	_, err := client.EnsureM2MApp(context.Background(), "Orders Worker", ensureM2MOptions())
+	require.NoError(t, err)
+
+	result, err := client.EnsureM2MApp(context.Background(), "Orders Worker", ensureM2MOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t, common.EnsureActionUnchanged, result.Action)
+	assert.Empty(t, result.Changes)
+	assert.Equal(t, 1, app.creates)
+	assert.Equal(t, 1, app.patches)
+	assert.Equal(t, 1, app.oauthPuts)
+}
+
+func TestEnsureM2MAppReportsTypeMismatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		exists     bool
+		templateId string
+		errMsg     string
+	}{
+		{"existing application of another type", true, "mobile-application", "application 'Orders Worker' already exists but is not of type m2m"},
+		{"existing application of unknown type", true, "custom-template", "failed to determine the type of application 'Orders Worker': unknown application type"},
+		{"created application of another type", false, "mobile-application", "created application 'Orders Worker' is of type mobile instead of m2m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &fakeM2MApplication{exists: tt.exists, templateId: stringPtr(tt.templateId)}
+			client := newTestClient(t, newTestServer(t, app.routes(t)))
+
+			_, err := client.EnsureM2MApp(context.Background(), "Orders Worker", ensureM2MOptions())
+			assert.EqualError(t, err, tt.errMsg)
+			assert.Equal(t, 0, app.patches)
+		})
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	var changes []common.FieldChange
+
+	assert.False(t, diffString(&changes, "description", stringPtr("same"), nil))
+	assert.False(t, diffString(&changes, "description", stringPtr("same"), stringPtr("same")))
+	assert.True(t, diffString(&changes, "description", nil, stringPtr("new")))
+	assert.True(t, diffString(&changes, "imageUrl", stringPtr("old"), stringPtr("new")))
+
+	assert.Equal(t, []common.FieldChange{
+		{Field: "description", OldValue: "", NewValue: "new"},
+		{Field: "imageUrl", OldValue: "old", NewValue: "new"},
+	}, changes)
+}
+
+func TestDiffInt64(t *testing.T) {
+	var changes []common.FieldChange
+
+	assert.False(t, diffInt64(&changes, "expiry", int64Ptr(3600), nil))
+	assert.False(t, diffInt64(&changes, "expiry", int64Ptr(3600), int64Ptr(3600)))
+	assert.True(t, diffInt64(&changes, "expiry", nil, int64Ptr(600)))
+	assert.True(t, diffInt64(&changes, "refresh", int64Ptr(3600), int64Ptr(600)))
+
+	assert.Equal(t, []common.FieldChange{
+		{Field: "expiry", OldValue: nil, NewValue: int64(600)},
+		{Field: "refresh", OldValue: int64(3600), NewValue: int64(600)},
+	}, changes)
+}
+
+func TestDiffStringSet(t *testing.T) {
+	var changes []common.FieldChange
+	current := []string{"b", "a"}
+
+	assert.False(t, diffStringSet(&changes, "origins", &current, nil))
+	assert.False(t, diffStringSet(&changes, "origins", &current, &[]string{"a", "b"}), "order must be ignored")
+	assert.True(t, diffStringSet(&changes, "origins", &current, &[]string{"a"}))
+	assert.True(t, diffStringSet(&changes, "attributes", nil, &[]string{"email"}))
+	assert.Equal(t, []string{"b", "a"}, current, "current values must not be reordered")
+
+	assert.Equal(t, []common.FieldChange{
+		{Field: "origins", OldValue: []string{"a", "b"}, NewValue: []string{"a"}},
+		{Field: "attributes", OldValue: []string{}, NewValue: []string{"email"}},
+	}, changes)
+}
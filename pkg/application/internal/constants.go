@@ -18,49 +18,10 @@
  
 package internal
 
-var FederatedAuthenticatorIDs = struct {
-	Apple      string
-	Duo        string
-	EmailOTP   string
-	Facebook   string
-	GitHub     string
-	GoogleOIDC string
-	Hypr       string
-	Iproov     string
-	IWAKrb     string
-	Microsoft  string
-	MSLive     string
-	Office365  string
-	OIDC       string
-	OrgEnt     string
-	PwdReset   string
-	SAML       string
-	SIWE       string
-	SMSOTP     string
-	Twitter    string
-	Yahoo      string
-}{
-	Apple:      "QXBwbGVPSURDQXV0aGVudGljYXRvcg",
-	Duo:        "RHVvQXV0aGVudGljYXRvcg",
-	EmailOTP:   "RW1haWxPVFA",
-	Facebook:   "RmFjZWJvb2tBdXRoZW50aWNhdG9y",
-	GitHub:     "R2l0aHViQXV0aGVudGljYXRvcg",
-	GoogleOIDC: "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I",
-	Hypr:       "SFlQUkF1dGhlbnRpY2F0b3I",
-	Iproov:     "SXByb292QXV0aGVudGljYXRvcg",
-	IWAKrb:     "SVdBS2VyYmVyb3NBdXRoZW50aWNhdG9y",
-	Microsoft:  "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
-	MSLive:     "TWljcm9zb2Z0V2luZG93c0xpdmVBdXRoZW50aWNhdG9y",
-	Office365:  "T2ZmaWNlMzY1QXV0aGVudGljYXRvcg",
-	OIDC:       "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
-	OrgEnt:     "T3JnYW5pemF0aW9uQXV0aGVudGljYXRvcg",
-	PwdReset:   "cGFzc3dvcmQtcmVzZXQtZW5mb3JjZXI",
-	SAML:       "U0FNTFNTT0F1dGhlbnRpY2F0b3I",
-	SIWE:       "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
-	SMSOTP:     "U01TT1RQ",
-	Twitter:    "VHdpdHRlckF1dGhlbnRpY2F0b3I",
-	Yahoo:      "WWFob29PQXV0aDJBdXRoZW50aWNhdG9y",
-}
+import "github.com/asgardeo/go/pkg/identity_provider"
+
+// FederatedAuthenticatorIDs are defined by the identity provider package
+var FederatedAuthenticatorIDs = identity_provider.FederatedAuthenticatorIDs
 
 var LocalAuthenticatorIDs = struct {
 	ActiveSessionLimitHandler string
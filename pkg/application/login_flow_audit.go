@@ -0,0 +1,338 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+)
+
+const auditPageSize int = 50
+
+// Authenticators that only identify the user and do not verify a credential
+var identifierAuthenticatorIDs = map[string]struct{}{
+	internal.LocalAuthenticatorIDs.IdentifierFirst:           {},
+	internal.LocalAuthenticatorIDs.ActiveSessionLimitHandler: {},
+}
+
+var passwordAuthenticatorIDs = map[string]struct{}{
+	internal.LocalAuthenticatorIDs.Basic:    {},
+	internal.LocalAuthenticatorIDs.JWTBasic: {},
+}
+
+// Authenticators that can be used as the only factor without a password
+var passwordlessAuthenticatorIDs = map[string]struct{}{
+	internal.LocalAuthenticatorIDs.FIDO:         {},
+	internal.LocalAuthenticatorIDs.MagicLink:    {},
+	internal.LocalAuthenticatorIDs.EmailOTP:     {},
+	internal.LocalAuthenticatorIDs.SMSOTP:       {},
+	internal.FederatedAuthenticatorIDs.EmailOTP: {},
+	internal.FederatedAuthenticatorIDs.SMSOTP:   {},
+}
+
+// OTP, push and passkey authenticators are accepted as second factors in addition to SecondFactorAuthenticatorIDs
+var additionalSecondFactorAuthenticatorIDs = map[string]struct{}{
+	internal.LocalAuthenticatorIDs.EmailOTP:     {},
+	internal.LocalAuthenticatorIDs.SMSOTP:       {},
+	internal.LocalAuthenticatorIDs.Push:         {},
+	internal.LocalAuthenticatorIDs.FIDO:         {},
+	internal.FederatedAuthenticatorIDs.EmailOTP: {},
+	internal.FederatedAuthenticatorIDs.SMSOTP:   {},
+}
+
+var (
+	executeStepPattern        = regexp.MustCompile(`executeStep\(\s*(\d+)`)
+	scriptConditionalPattern  = regexp.MustCompile(`\bif\s*\(|\?[^:]*:|\bswitch\s*\(`)
+	scriptBlockCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	scriptLineCommentPattern  = regexp.MustCompile(`(?m)//.*$`)
+)
+
+// AuditLoginFlows walks all applications in the tenant and classifies the strength of their login flows.
+// Applications whose login flow cannot be retrieved are reported as LoginFlowAuditFailed with the error.
+func (c *ApplicationClient) AuditLoginFlows(ctx context.Context) (*LoginFlowAuditReportModel, error) {
+	resolver, err := c.buildAuthenticatorResolver(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve login flow authenticators: %w", err)
+	}
+
+	report := &LoginFlowAuditReportModel{
+		GeneratedAt:  time.Now().UTC(),
+		Summary:      make(map[LoginFlowClassification]int),
+		Applications: []LoginFlowAuditEntryModel{},
+	}
+
+	offset := 0
+	for {
+		page, err := c.List(ctx, auditPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to audit login flows: %w", err)
+		}
+		if page == nil || page.Applications == nil || len(*page.Applications) == 0 {
+			break
+		}
+
+		for _, app := range *page.Applications {
+			if app.Id == nil {
+				continue
+			}
+			var entry LoginFlowAuditEntryModel
+			loginFlow, err := c.getResolvedLoginFlow(ctx, *app.Id, resolver)
+			if err != nil {
+				entry = LoginFlowAuditEntryModel{
+					Classification:             LoginFlowAuditFailed,
+					FirstFactorAuthenticators:  []string{},
+					SecondFactorAuthenticators: []string{},
+					Error:                      err.Error(),
+				}
+			} else {
+				entry = classifyLoginFlow(loginFlow)
+			}
+			entry.ApplicationId = *app.Id
+			if app.Name != nil {
+				entry.ApplicationName = *app.Name
+			}
+			if app.ClientId != nil {
+				entry.ClientId = *app.ClientId
+			}
+			report.Applications = append(report.Applications, entry)
+			report.Summary[entry.Classification]++
+		}
+
+		offset += len(*page.Applications)
+		if page.TotalResults != nil && offset >= *page.TotalResults {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// ApplicationsWithoutMFA returns the audited applications that do not enforce a second factor,
+// including MFA applications whose adaptive script may bypass the second factor step and
+// applications whose login flow could not be audited.
+func (r *LoginFlowAuditReportModel) ApplicationsWithoutMFA() []LoginFlowAuditEntryModel {
+	entries := []LoginFlowAuditEntryModel{}
+	for _, entry := range r.Applications {
+		if entry.Classification != LoginFlowMFA || entry.ScriptMayBypassSteps {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// WriteJSON writes the audit report as indented JSON
+func (r *LoginFlowAuditReportModel) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("failed to write audit report as JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the audit report as CSV with one row per application
+func (r *LoginFlowAuditReportModel) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{
+		"application_id",
+		"application_name",
+		"client_id",
+		"classification",
+		"step_count",
+		"first_factor_authenticators",
+		"second_factor_authenticators",
+		"has_adaptive_script",
+		"script_may_bypass_steps",
+		"findings",
+		"error",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write audit report as CSV: %w", err)
+	}
+
+	for _, entry := range r.Applications {
+		record := []string{
+			entry.ApplicationId,
+			entry.ApplicationName,
+			entry.ClientId,
+			string(entry.Classification),
+			strconv.Itoa(entry.StepCount),
+			strings.Join(entry.FirstFactorAuthenticators, ";"),
+			strings.Join(entry.SecondFactorAuthenticators, ";"),
+			strconv.FormatBool(entry.HasAdaptiveScript),
+			strconv.FormatBool(entry.ScriptMayBypassSteps),
+			strings.Join(entry.Findings, ";"),
+			entry.Error,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write audit report as CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write audit report as CSV: %w", err)
+	}
+	return nil
+}
+
+// classifyLoginFlow classifies a resolved login flow by the factors its steps enforce
+func classifyLoginFlow(loginFlow *LoginFlowResponseModel) LoginFlowAuditEntryModel {
+	entry := LoginFlowAuditEntryModel{
+		Classification:             LoginFlowOther,
+		StepCount:                  len(loginFlow.Steps),
+		FirstFactorAuthenticators:  []string{},
+		SecondFactorAuthenticators: []string{},
+	}
+
+	// Steps that only identify the user do not count as a factor
+	var factorSteps []LoginFlowStepDetailsModel
+	for _, step := range loginFlow.Steps {
+		for _, option := range step.Options {
+			if _, isIdentifier := identifierAuthenticatorIDs[option.AuthenticatorId]; !isIdentifier {
+				factorSteps = append(factorSteps, step)
+				break
+			}
+		}
+	}
+
+	if len(factorSteps) == 0 {
+		entry.Findings = append(entry.Findings, "login flow has no authentication steps")
+		return entry
+	}
+
+	firstStep := factorSteps[0]
+	allPasswordless, allSocial, hasPassword := true, true, false
+	for _, option := range firstStep.Options {
+		if _, isIdentifier := identifierAuthenticatorIDs[option.AuthenticatorId]; isIdentifier {
+			continue
+		}
+		entry.FirstFactorAuthenticators = append(entry.FirstFactorAuthenticators, authenticatorLabel(option))
+		if _, ok := passwordlessAuthenticatorIDs[option.AuthenticatorId]; !ok {
+			allPasswordless = false
+		}
+		if _, ok := internal.SocialAuthenticatorIDs[option.AuthenticatorId]; !ok {
+			allSocial = false
+		}
+		if _, ok := passwordAuthenticatorIDs[option.AuthenticatorId]; ok {
+			hasPassword = true
+		}
+	}
+
+	hasMFA := false
+	for _, step := range factorSteps[1:] {
+		stepEnforcesSecondFactor := len(step.Options) > 0
+		for _, option := range step.Options {
+			if isSecondFactorAuthenticator(option.AuthenticatorId) {
+				entry.SecondFactorAuthenticators = append(entry.SecondFactorAuthenticators, authenticatorLabel(option))
+			} else {
+				stepEnforcesSecondFactor = false
+				entry.Findings = append(entry.Findings,
+					fmt.Sprintf("step %d allows '%s', which is not a second factor", step.Id, authenticatorLabel(option)))
+			}
+		}
+		if stepEnforcesSecondFactor {
+			hasMFA = true
+		}
+	}
+
+	switch {
+	case hasMFA:
+		entry.Classification = LoginFlowMFA
+	case allPasswordless:
+		entry.Classification = LoginFlowPasswordless
+	case allSocial:
+		entry.Classification = LoginFlowSocialOnly
+	case hasPassword:
+		entry.Classification = LoginFlowPasswordOnly
+	}
+
+	loginFlowType := loginFlow.LoginFlow.Type
+	if loginFlow.LoginFlow.Script != nil && strings.TrimSpace(*loginFlow.LoginFlow.Script) != "" &&
+		(loginFlowType == nil || *loginFlowType == internal.USERDEFINED) {
+		entry.HasAdaptiveScript = true
+		stepIds := make([]int, 0, len(loginFlow.Steps))
+		for _, step := range loginFlow.Steps {
+			stepIds = append(stepIds, step.Id)
+		}
+		mayBypass, findings := analyzeAdaptiveScript(*loginFlow.LoginFlow.Script, stepIds)
+		entry.ScriptMayBypassSteps = mayBypass
+		entry.Findings = append(entry.Findings, findings...)
+	}
+
+	return entry
+}
+
+// analyzeAdaptiveScript reports whether an adaptive script may skip any of the configured steps
+func analyzeAdaptiveScript(script string, stepIds []int) (bool, []string) {
+	code := scriptBlockCommentPattern.ReplaceAllString(script, "")
+	code = scriptLineCommentPattern.ReplaceAllString(code, "")
+
+	executedSteps := make(map[int]struct{})
+	for _, match := range executeStepPattern.FindAllStringSubmatch(code, -1) {
+		if stepId, err := strconv.Atoi(match[1]); err == nil {
+			executedSteps[stepId] = struct{}{}
+		}
+	}
+
+	var findings []string
+	mayBypass := false
+	sort.Ints(stepIds)
+	for _, stepId := range stepIds {
+		if _, ok := executedSteps[stepId]; !ok {
+			mayBypass = true
+			findings = append(findings, fmt.Sprintf("adaptive script never executes step %d", stepId))
+		}
+	}
+
+	if len(stepIds) > 1 && scriptConditionalPattern.MatchString(code) {
+		mayBypass = true
+		findings = append(findings, "adaptive script executes steps conditionally")
+	}
+
+	return mayBypass, findings
+}
+
+func isSecondFactorAuthenticator(authenticatorId string) bool {
+	if _, ok := internal.SecondFactorAuthenticatorIDs[authenticatorId]; ok {
+		return true
+	}
+	_, ok := additionalSecondFactorAuthenticatorIDs[authenticatorId]
+	return ok
+}
+
+func authenticatorLabel(option LoginFlowOptionDetailsModel) string {
+	if !option.IsLocal {
+		return option.Idp
+	}
+	if option.AuthenticatorDisplayName != "" {
+		return option.AuthenticatorDisplayName
+	}
+	return option.Authenticator
+}
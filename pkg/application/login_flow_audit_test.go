@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyLoginFlow(t *testing.T) {
+	basic := LoginFlowOptionDetailsModel{Authenticator: "BasicAuthenticator", AuthenticatorId: internal.LocalAuthenticatorIDs.Basic, Idp: "LOCAL", IsLocal: true}
+	totp := LoginFlowOptionDetailsModel{Authenticator: "totp", AuthenticatorId: internal.LocalAuthenticatorIDs.TOTP, Idp: "LOCAL", IsLocal: true}
+	google := LoginFlowOptionDetailsModel{Authenticator: "GoogleOIDCAuthenticator", AuthenticatorId: internal.FederatedAuthenticatorIDs.GoogleOIDC, Idp: "Google"}
+	fido := LoginFlowOptionDetailsModel{Authenticator: "FIDOAuthenticator", AuthenticatorId: internal.LocalAuthenticatorIDs.FIDO, Idp: "LOCAL", IsLocal: true}
+
+	userDefined := internal.USERDEFINED
+	script := "var onLoginRequest = function(context) {\n    executeStep(1);\n    if (context.request.ip != '10.0.0.1') {\n        executeStep(2);\n    }\n};"
+
+	tests := []struct {
+		name           string
+		steps          []LoginFlowStepDetailsModel
+		script         *string
+		classification LoginFlowClassification
+		mayBypass      bool
+	}{
+		{"password only", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{basic}}}, nil, LoginFlowPasswordOnly, false},
+		{"social only", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{google}}}, nil, LoginFlowSocialOnly, false},
+		{"passwordless", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{fido}}}, nil, LoginFlowPasswordless, false},
+		{"mfa", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{basic, google}}, {Id: 2, Options: []LoginFlowOptionDetailsModel{totp}}}, nil, LoginFlowMFA, false},
+		{"mfa with weak second step", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{basic}}, {Id: 2, Options: []LoginFlowOptionDetailsModel{totp, basic}}}, nil, LoginFlowPasswordOnly, false},
+		{"mfa with conditional script", []LoginFlowStepDetailsModel{{Id: 1, Options: []LoginFlowOptionDetailsModel{basic}}, {Id: 2, Options: []LoginFlowOptionDetailsModel{totp}}}, &script, LoginFlowMFA, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loginFlow := &LoginFlowResponseModel{
+				LoginFlow: LoginFlowUpdateModel{Type: &userDefined, Script: tt.script},
+				Steps:     tt.steps,
+			}
+			entry := classifyLoginFlow(loginFlow)
+			assert.Equal(t, tt.classification, entry.Classification)
+			assert.Equal(t, tt.mayBypass, entry.ScriptMayBypassSteps)
+		})
+	}
+}
+
+func TestAuditLoginFlowsRecordsFailedApplications(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"totalResults": 2, "applications": [
+				{"id": "app-1", "name": "Pickup", "clientId": "pickup-client"},
+				{"id": "app-2", "name": "Restricted"}
+			]}`)
+		},
+		"GET /api/server/v1/applications/app-1": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":"app-1","name":"Pickup","authenticationSequence":{"type":"USER_DEFINED","steps":[
+				{"id":1,"options":[{"idp":"LOCAL","authenticator":"BasicAuthenticator"}]},
+				{"id":2,"options":[{"idp":"LOCAL","authenticator":"totp"}]}]}}`)
+		},
+		"GET /api/server/v1/applications/app-2": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusForbidden, `{"code": "APP-60004"}`)
+		},
+		"GET /api/server/v1/authenticators": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, localAuthenticatorsResponse)
+		},
+		"GET /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"totalResults": 0, "identityProviders": []}`)
+		},
+	})
+	client := newTestClient(t, server)
+
+	report, err := client.AuditLoginFlows(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Applications, 2)
+
+	assert.Equal(t, "app-1", report.Applications[0].ApplicationId)
+	assert.Equal(t, "pickup-client", report.Applications[0].ClientId)
+	assert.Equal(t, LoginFlowMFA, report.Applications[0].Classification)
+	assert.Empty(t, report.Applications[0].Error)
+
+	assert.Equal(t, "Restricted", report.Applications[1].ApplicationName)
+	assert.Equal(t, LoginFlowAuditFailed, report.Applications[1].Classification)
+	assert.Contains(t, report.Applications[1].Error, "APP-60004")
+	assert.Equal(t, map[LoginFlowClassification]int{LoginFlowMFA: 1, LoginFlowAuditFailed: 1}, report.Summary)
+}
+
+func testAuditReport() *LoginFlowAuditReportModel {
+	return &LoginFlowAuditReportModel{
+		Summary: map[LoginFlowClassification]int{LoginFlowMFA: 2, LoginFlowPasswordOnly: 1, LoginFlowAuditFailed: 1},
+		Applications: []LoginFlowAuditEntryModel{
+			{ApplicationId: "app-1", ApplicationName: "Pickup", Classification: LoginFlowMFA, StepCount: 2,
+				FirstFactorAuthenticators: []string{"Username & Password"}, SecondFactorAuthenticators: []string{"TOTP"}},
+			{ApplicationId: "app-2", ApplicationName: "Legacy", Classification: LoginFlowPasswordOnly, StepCount: 1,
+				FirstFactorAuthenticators: []string{"Username & Password"}, SecondFactorAuthenticators: []string{}},
+			{ApplicationId: "app-3", ApplicationName: "Scripted", Classification: LoginFlowMFA, StepCount: 2, HasAdaptiveScript: true,
+				ScriptMayBypassSteps: true, Findings: []string{"adaptive script executes steps conditionally", "adaptive script never executes step 2"}},
+			{ApplicationId: "app-4", ApplicationName: "Restricted", Classification: LoginFlowAuditFailed, Error: "status 403"},
+		},
+	}
+}
+
+func TestApplicationsWithoutMFA(t *testing.T) {
+	var ids []string
+	for _, entry := range testAuditReport().ApplicationsWithoutMFA() {
+		ids = append(ids, entry.ApplicationId)
+	}
+	assert.Equal(t, []string{"app-2", "app-3", "app-4"}, ids)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, testAuditReport().WriteJSON(&buffer))
+
+	var decoded LoginFlowAuditReportModel
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &decoded))
+	assert.Equal(t, 2, decoded.Summary[LoginFlowMFA])
+	require.Len(t, decoded.Applications, 4)
+	assert.Equal(t, "status 403", decoded.Applications[3].Error)
+	assert.Contains(t, buffer.String(), "\n  \"applications\"")
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, testAuditReport().WriteCSV(&buffer))
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, "application_id", records[0][0])
+	assert.Equal(t, "error", records[0][len(records[0])-1])
+	assert.Equal(t, []string{"app-1", "Pickup", "", "mfa", "2", "Username & Password", "TOTP", "false", "false", "", ""}, records[1])
+	assert.Equal(t, "adaptive script executes steps conditionally;adaptive script never executes step 2", records[3][9])
+	assert.Equal(t, []string{"app-4", "Restricted", "", "audit_failed", "0", "", "", "false", "false", "", "status 403"}, records[4])
+}
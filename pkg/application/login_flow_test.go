@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const localAuthenticatorsResponse = `[
+	{"id":"QmFzaWNBdXRoZW50aWNhdG9y","name":"BasicAuthenticator","displayName":"Username & Password","type":"LOCAL"},
+	{"id":"dG90cA","name":"totp","displayName":"TOTP","type":"LOCAL"},
+	{"id":"R29vZ2xl","name":"Google","displayName":"Google Sign-In","type":"FEDERATED"}
+]`
+
+// identityProvidersHandler serves the given identity providers after a full first page of unrelated ones
+func identityProvidersHandler(t *testing.T, identityProviders ...map[string]interface{}) http.HandlerFunc {
+	filler := make([]map[string]interface{}, 0, identityProviderPageSize)
+	for i := 0; i < int(identityProviderPageSize); i++ {
+		filler = append(filler, map[string]interface{}{"id": fmt.Sprintf("idp-%d", i), "name": fmt.Sprintf("IdP %d", i)})
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		total := len(filler) + len(identityProviders)
+		page := filler
+		if r.URL.Query().Get("offset") != "0" {
+			assert.Equal(t, fmt.Sprint(identityProviderPageSize), r.URL.Query().Get("offset"))
+			page = identityProviders
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"totalResults": total, "identityProviders": page})
+	}
+}
+
+var googleIdentityProvider = map[string]interface{}{
+	"id":   "google-idp-id",
+	"name": "Google",
+	"federatedAuthenticators": map[string]interface{}{
+		"defaultAuthenticatorId": "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I",
+		"authenticators": []map[string]interface{}{
+			{"authenticatorId": "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I", "name": "GoogleOIDCAuthenticator"},
+		},
+	},
+}
+
+func TestGetLoginFlowResolvesIdentityProvidersBeyondFirstPage(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/app-1": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":"app-1","name":"Pickup","authenticationSequence":{"type":"USER_DEFINED","steps":[
+				{"id":1,"options":[{"idp":"LOCAL","authenticator":"BasicAuthenticator"},{"idp":"Google","authenticator":"GoogleOIDCAuthenticator"}]},
+				{"id":2,"options":[{"idp":"LOCAL","authenticator":"totp"}]}]}}`)
+		},
+		"GET /api/server/v1/authenticators": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, localAuthenticatorsResponse)
+		},
+		"GET /api/server/v1/identity-providers": identityProvidersHandler(t, googleIdentityProvider),
+	})
+	client := newTestClient(t, server)
+
+	loginFlow, err := client.GetLoginFlow(context.Background(), "app-1")
+	require.NoError(t, err)
+	require.Len(t, loginFlow.Steps, 2)
+
+	assert.Equal(t, LoginFlowOptionDetailsModel{
+		Authenticator:            "BasicAuthenticator",
+		AuthenticatorId:          "QmFzaWNBdXRoZW50aWNhdG9y",
+		AuthenticatorDisplayName: "Username & Password",
+		Idp:                      "LOCAL",
+		IsLocal:                  true,
+	}, loginFlow.Steps[0].Options[0])
+	assert.Equal(t, LoginFlowOptionDetailsModel{
+		Authenticator:            "GoogleOIDCAuthenticator",
+		AuthenticatorId:          "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I",
+		AuthenticatorDisplayName: "Google Sign-In",
+		Idp:                      "Google",
+		IdpId:                    "google-idp-id",
+	}, loginFlow.Steps[0].Options[1])
+	assert.Equal(t, "dG90cA", loginFlow.Steps[1].Options[0].AuthenticatorId)
+	assert.Equal(t, 2, loginFlow.Steps[1].Id)
+}
+
+func TestGetLoginFlowFallsBackToConfiguredAuthenticators(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/app-2": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id":"app-2","name":"Default Flow"}`)
+		},
+		"GET /api/server/v1/applications/app-2/authenticators": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"stepId":1,
+				"localAuthenticators":[{"name":"BasicAuthenticator"}],
+				"federatedAuthenticators":[{"name":"Google","type":"GoogleOIDCAuthenticator"}]}]`)
+		},
+		"GET /api/server/v1/authenticators": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, localAuthenticatorsResponse)
+		},
+		"GET /api/server/v1/identity-providers": identityProvidersHandler(t, googleIdentityProvider),
+	})
+	client := newTestClient(t, server)
+
+	loginFlow, err := client.GetLoginFlow(context.Background(), "app-2")
+	require.NoError(t, err)
+
+	require.NotNil(t, loginFlow.LoginFlow.Steps)
+	assert.Equal(t, []LoginFlowStepModel{{Id: 1, Options: []AuthenticatorModel{
+		{Authenticator: "BasicAuthenticator", Idp: "LOCAL"},
+		{Authenticator: "GoogleOIDCAuthenticator", Idp: "Google"},
+	}}}, *loginFlow.LoginFlow.Steps)
+	require.Len(t, loginFlow.Steps, 1)
+	assert.Equal(t, "QmFzaWNBdXRoZW50aWNhdG9y", loginFlow.Steps[0].Options[0].AuthenticatorId)
+	assert.Equal(t, "google-idp-id", loginFlow.Steps[0].Options[1].IdpId)
+}
+
+// loginFlowRoutes serves an application with the given login flow and records the patched login flow
+func loginFlowRoutes(t *testing.T, loginFlow string, patched *internal.ApplicationPatchModel) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "app-id", "name": "Pickup", "authenticationSequence": `+loginFlow+`}`)
+		},
+		"PATCH /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(patched))
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+}
+
+func TestAddIdentityProviderToLoginFlow(t *testing.T) {
+	var patched internal.ApplicationPatchModel
+	client := newTestClient(t, newTestServer(t, loginFlowRoutes(t, `{
+		"type": "USER_DEFINED", "subjectStepId": 1, "attributeStepId": 1, "script": "var onLoginRequest = function(context) { executeStep(1); };",
+		"steps": [
+			{"id": 1, "options": [{"idp": "LOCAL", "authenticator": "BasicAuthenticator"}]},
+			{"id": 2, "options": [{"idp": "LOCAL", "authenticator": "totp"}]}
+		]}`, &patched)))
+
+	err := client.AddIdentityProviderToLoginFlow(context.Background(), "app-id", "Google", "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I", false)
+	require.NoError(t, err)
+
+	loginFlow := patched.AuthenticationSequence
+	require.NotNil(t, loginFlow)
+	assert.Equal(t, internal.USERDEFINED, *loginFlow.Type)
+	assert.Equal(t, "var onLoginRequest = function(context) { executeStep(1); };", *loginFlow.Script)
+	assert.Equal(t, 1, *loginFlow.SubjectStepId)
+	assert.Equal(t, []LoginFlowStepModel{
+		{Id: 1, Options: []AuthenticatorModel{
+			{Idp: "LOCAL", Authenticator: "BasicAuthenticator"},
+			{Idp: "Google", Authenticator: "GoogleOIDCAuthenticator"},
+		}},
+		{Id: 2, Options: []AuthenticatorModel{{Idp: "LOCAL", Authenticator: "totp"}}},
+	}, *loginFlow.Steps)
+}
+
+func TestAddIdentityProviderToEmptyLoginFlow(t *testing.T) {
+	tests := []struct {
+		name                      string
+		includeBasicAuthenticator bool
+		expectedOptions           []AuthenticatorModel
+	}{
+		{
+			name:            "identity provider only",
+			expectedOptions: []AuthenticatorModel{{Idp: "GitHub", Authenticator: "GithubAuthenticator"}},
+		},
+		{
+			name:                      "with basic authenticator",
+			includeBasicAuthenticator: true,
+			expectedOptions: []AuthenticatorModel{
+				{Idp: "LOCAL", Authenticator: "BasicAuthenticator"},
+				{Idp: "GitHub", Authenticator: "GithubAuthenticator"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patched internal.ApplicationPatchModel
+			client := newTestClient(t, newTestServer(t, loginFlowRoutes(t, `{"type": "DEFAULT"}`, &patched)))
+
+			err := client.AddIdentityProviderToLoginFlow(context.Background(), "app-id", "GitHub", "R2l0aHViQXV0aGVudGljYXRvcg",
+				tt.includeBasicAuthenticator)
+			require.NoError(t, err)
+			require.NotNil(t, patched.AuthenticationSequence)
+			assert.Equal(t, internal.USERDEFINED, *patched.AuthenticationSequence.Type)
+			assert.Equal(t, []LoginFlowStepModel{{Id: 1, Options: tt.expectedOptions}}, *patched.AuthenticationSequence.Steps)
+		})
+	}
+}
+
+func TestAddIdentityProviderToLoginFlowSkipsIdentityProviderAlreadyInLoginFlow(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "app-id", "name": "Pickup", "authenticationSequence": {"type": "USER_DEFINED",
+				"steps": [{"id": 1, "options": [{"idp": "Google", "authenticator": "GoogleOIDCAuthenticator"}]}]}}`)
+		},
+	}))
+
+	err := client.AddIdentityProviderToLoginFlow(context.Background(), "app-id", "Google", "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I", false)
+	require.NoError(t, err)
+}
+
+func TestAddIdentityProviderToLoginFlowReportsMissingApplication(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusNotFound, `{"code": "APP-60006"}`)
+		},
+	}))
+
+	err := client.AddIdentityProviderToLoginFlow(context.Background(), "app-id", "Google", "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I", false)
+	assert.EqualError(t, err, `failed to add identity provider to login flow: `+
+		`failed to get application details: status 404, body: {"code": "APP-60006"}`)
+}
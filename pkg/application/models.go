@@ -19,7 +19,11 @@
 package application
 
 import (
+	"time"
+
 	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/asgardeo/go/pkg/common"
+	"github.com/asgardeo/go/pkg/config"
 )
 
 // AppType represents the type of application
@@ -104,6 +108,157 @@ type AuthenticatorModel = internal.Authenticator
 
 type LoginFlowTypeModel = internal.AuthenticationSequenceType
 
+type ConfiguredAuthenticatorsModel = internal.ConfiguredAuthenticatorsModal
+
+// LoginFlowResponseModel defines the current login flow of an application along with resolved authenticator details
+type LoginFlowResponseModel struct {
+	// LoginFlow is the authentication sequence as configured, which can be passed back to UpdateLoginFlow
+	LoginFlow LoginFlowUpdateModel        `json:"loginFlow"`
+	Steps     []LoginFlowStepDetailsModel `json:"steps"`
+}
+
+// LoginFlowStepDetailsModel defines a login flow step with resolved authenticator details
+type LoginFlowStepDetailsModel struct {
+	Id      int                           `json:"id"`
+	Options []LoginFlowOptionDetailsModel `json:"options"`
+}
+
+// LoginFlowOptionDetailsModel defines an authenticator option of a login flow step
+type LoginFlowOptionDetailsModel struct {
+	Authenticator            string `json:"authenticator"`
+	AuthenticatorId          string `json:"authenticatorId,omitempty"`
+	AuthenticatorDisplayName string `json:"authenticatorDisplayName,omitempty"`
+	Idp                      string `json:"idp"`
+	IdpId                    string `json:"idpId,omitempty"`
+	IsLocal                  bool   `json:"isLocal"`
+}
+
+// ApplicationCloneOverridesModel defines the fields to override when cloning an application
+type ApplicationCloneOverridesModel struct {
+	Description     *string   `json:"description,omitempty"`
+	ImageUrl        *string   `json:"imageUrl,omitempty"`
+	AccessUrl       *string   `json:"accessUrl,omitempty"`
+	LogoutReturnUrl *string   `json:"logoutReturnUrl,omitempty"`
+	CallbackURLs    *[]string `json:"callbackURLs,omitempty"`
+	AllowedOrigins  *[]string `json:"allowedOrigins,omitempty"`
+
+	// TargetConfig is the client configuration of the tenant to clone into, such as the Config of another sdk.Client.
+	// The application is cloned into the source tenant when nil.
+	TargetConfig *config.ClientConfig `json:"-"`
+}
+
+// ApplicationCloneResultModel contains the cloned application and any configuration that could not be cloned
+type ApplicationCloneResultModel struct {
+	Application *ApplicationBasicInfoResponseModel `json:"application"`
+	Warnings    []string                           `json:"warnings,omitempty"`
+}
+
+// ApplicationEnsureOptionsModel defines additional fields to reconcile when ensuring an application.
+// The application name is used to look up the application and is never changed.
+type ApplicationEnsureOptionsModel struct {
+	BasicInfo   *ApplicationBasicInfoUpdateModel   `json:"basicInfo,omitempty"`
+	OAuthConfig *ApplicationOAuthConfigUpdateModel `json:"oauthConfig,omitempty"`
+}
+
+// ApplicationEnsureResultModel defines the outcome of ensuring an application
+type ApplicationEnsureResultModel struct {
+	Action      common.EnsureAction                `json:"action"`
+	Application *ApplicationBasicInfoResponseModel `json:"application"`
+	Changes     []common.FieldChange               `json:"changes,omitempty"`
+}
+
+// LoginFlowClassification represents the strength of an application's login flow
+type LoginFlowClassification string
+
+// Login flow classifications as typed constants
+const (
+	LoginFlowPasswordOnly LoginFlowClassification = "password_only"
+	LoginFlowSocialOnly   LoginFlowClassification = "social_only"
+	LoginFlowMFA          LoginFlowClassification = "mfa"
+	LoginFlowPasswordless LoginFlowClassification = "passwordless"
+	LoginFlowOther        LoginFlowClassification = "other"
+	// LoginFlowAuditFailed marks applications whose login flow could not be retrieved
+	LoginFlowAuditFailed LoginFlowClassification = "audit_failed"
+)
+
+// LoginFlowAuditEntryModel defines the audit result of a single application's login flow
+type LoginFlowAuditEntryModel struct {
+	ApplicationId              string                  `json:"applicationId"`
+	ApplicationName            string                  `json:"applicationName"`
+	ClientId                   string                  `json:"clientId,omitempty"`
+	Classification             LoginFlowClassification `json:"classification"`
+	StepCount                  int                     `json:"stepCount"`
+	FirstFactorAuthenticators  []string                `json:"firstFactorAuthenticators"`
+	SecondFactorAuthenticators []string                `json:"secondFactorAuthenticators"`
+	HasAdaptiveScript          bool                    `json:"hasAdaptiveScript"`
+	ScriptMayBypassSteps       bool                    `json:"scriptMayBypassSteps"`
+	Findings                   []string                `json:"findings,omitempty"`
+	Error                      string                  `json:"error,omitempty"`
+}
+
+// LoginFlowAuditReportModel defines the tenant-wide login flow audit report
+type LoginFlowAuditReportModel struct {
+	GeneratedAt  time.Time                       `json:"generatedAt"`
+	Summary      map[LoginFlowClassification]int `json:"summary"`
+	Applications []LoginFlowAuditEntryModel      `json:"applications"`
+}
+
+// CertificateType represents how an application's certificate is registered
+type CertificateType string
+
+// Certificate types as typed constants
+const (
+	CertificateTypePEM  CertificateType = "PEM"
+	CertificateTypeJWKS CertificateType = "JWKS"
+)
+
+// ApplicationCertificateModel defines the certificate or JWKS endpoint registered for an application
+type ApplicationCertificateModel struct {
+	Type  CertificateType `json:"type"`
+	Value string          `json:"value"`
+
+	// Parsed certificate details, populated only when Type is PEM
+	Subject      string     `json:"subject,omitempty"`
+	Issuer       string     `json:"issuer,omitempty"`
+	SerialNumber string     `json:"serialNumber,omitempty"`
+	NotBefore    *time.Time `json:"notBefore,omitempty"`
+	NotAfter     *time.Time `json:"notAfter,omitempty"`
+}
+
+// ExpiresWithin reports whether a PEM certificate expires within the given window from now
+func (m *ApplicationCertificateModel) ExpiresWithin(window time.Duration) bool {
+	if m.NotAfter == nil {
+		return false
+	}
+	return time.Now().Add(window).After(*m.NotAfter)
+}
+
+// CertificateOptions defines options used when registering an application certificate
+type CertificateOptions struct {
+	// ExpiryWarningWindow is the period before expiry in which a warning is reported. Defaults to 30 days.
+	ExpiryWarningWindow time.Duration
+}
+
+// CertificateUpdateResultModel contains the registered certificate and any warnings raised during validation
+type CertificateUpdateResultModel struct {
+	Certificate ApplicationCertificateModel `json:"certificate"`
+	Warnings    []string                    `json:"warnings,omitempty"`
+}
+
+type ProvisioningConfigurationModel = internal.ProvisioningConfiguration
+
+type OutboundProvisioningIdPModel = internal.OutboundProvisioningConfiguration
+
+// OutboundProvisioningOptionsModel defines how users of an application are provisioned through an outbound connector
+type OutboundProvisioningOptionsModel struct {
+	// Blocking waits for provisioning to complete before the user operation returns
+	Blocking bool
+	// Rules applies the provisioning rules configured on the connector
+	Rules bool
+	// JIT also provisions users created through just-in-time provisioning
+	JIT bool
+}
+
 // convertBasicInfoUpdateModelToApplicationPatchModel converts the public ApplicationBasicInfoUpdateModel to the internal PatchApplicationJSONRequestBody
 func convertBasicInfoUpdateModelToApplicationPatchModel(model ApplicationBasicInfoUpdateModel) internal.PatchApplicationJSONRequestBody {
 	return internal.PatchApplicationJSONRequestBody{
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/asgardeo/go/pkg/identity_provider"
+)
+
+// GetProvisioningConfig retrieves the inbound and outbound provisioning configuration of an application.
+func (c *ApplicationClient) GetProvisioningConfig(ctx context.Context, appId string) (*ProvisioningConfigurationModel, error) {
+	app, err := c.fetchApplicationDetails(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	if app.ProvisioningConfigurations == nil {
+		return &ProvisioningConfigurationModel{}, nil
+	}
+	return app.ProvisioningConfigurations, nil
+}
+
+// EnableOutboundProvisioning provisions users of an application to an identity provider through one of its
+// outbound connectors. The connector must be enabled on the identity provider. An existing outbound provisioning
+// entry for the identity provider is replaced.
+func (c *ApplicationClient) EnableOutboundProvisioning(ctx context.Context, appId string, idpName string, connectorId string,
+	opts *OutboundProvisioningOptionsModel) (*ProvisioningConfigurationModel, error) {
+	if opts == nil {
+		opts = &OutboundProvisioningOptionsModel{}
+	}
+
+	connectorName, err := c.resolveOutboundConnectorName(ctx, idpName, connectorId)
+	if err != nil {
+		return nil, err
+	}
+
+	provisioningConfig, err := c.GetProvisioningConfig(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	outboundIdps := removeOutboundProvisioningIdP(provisioningConfig.OutboundProvisioningIdps, idpName)
+	blocking, rules, jit := opts.Blocking, opts.Rules, opts.JIT
+	outboundIdps = append(outboundIdps, internal.OutboundProvisioningConfiguration{
+		Idp:       &idpName,
+		Connector: &connectorName,
+		Blocking:  &blocking,
+		Rules:     &rules,
+		Jit:       &jit,
+	})
+	provisioningConfig.OutboundProvisioningIdps = &outboundIdps
+
+	if err := c.updateProvisioningConfig(ctx, appId, provisioningConfig); err != nil {
+		return nil, err
+	}
+	return provisioningConfig, nil
+}
+
+// DisableOutboundProvisioning stops provisioning users of an application to an identity provider.
+func (c *ApplicationClient) DisableOutboundProvisioning(ctx context.Context, appId string, idpName string) (*ProvisioningConfigurationModel, error) {
+	provisioningConfig, err := c.GetProvisioningConfig(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	outboundIdps := removeOutboundProvisioningIdP(provisioningConfig.OutboundProvisioningIdps, idpName)
+	if provisioningConfig.OutboundProvisioningIdps != nil && len(outboundIdps) == len(*provisioningConfig.OutboundProvisioningIdps) {
+		return provisioningConfig, nil
+	}
+	provisioningConfig.OutboundProvisioningIdps = &outboundIdps
+
+	if err := c.updateProvisioningConfig(ctx, appId, provisioningConfig); err != nil {
+		return nil, err
+	}
+	return provisioningConfig, nil
+}
+
+func (c *ApplicationClient) updateProvisioningConfig(ctx context.Context, appId string, provisioningConfig *ProvisioningConfigurationModel) error {
+	resp, err := c.apiClient.PatchApplicationWithResponse(ctx, appId, internal.ApplicationPatchModel{
+		ProvisioningConfigurations: provisioningConfig,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update provisioning configuration: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update provisioning configuration: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// resolveOutboundConnectorName checks that the connector is enabled on the identity provider and returns its name,
+// which is how applications refer to outbound connectors.
+func (c *ApplicationClient) resolveOutboundConnectorName(ctx context.Context, idpName string, connectorId string) (string, error) {
+	identityProviderClient, err := identity_provider.New(c.config)
+	if err != nil {
+		return "", fmt.Errorf("failed to create identity provider client: %w", err)
+	}
+	idp, err := identityProviderClient.GetByName(ctx, idpName)
+	if err != nil {
+		return "", err
+	}
+	if idp.Id == nil {
+		return "", fmt.Errorf("identity provider '%s' has no ID", idpName)
+	}
+	connector, err := identityProviderClient.GetOutboundConnector(ctx, *idp.Id, connectorId)
+	if err != nil {
+		return "", err
+	}
+	if !connector.IsEnabled {
+		return "", fmt.Errorf("outbound connector '%s' is not enabled on identity provider '%s'", connectorId, idpName)
+	}
+
+	if connector.Name != "" {
+		return connector.Name, nil
+	}
+	name, err := base64.RawURLEncoding.DecodeString(connectorId)
+	if err != nil {
+		return "", fmt.Errorf("invalid outbound connector ID '%s': %w", connectorId, err)
+	}
+	return string(name), nil
+}
+
+func removeOutboundProvisioningIdP(outboundIdps *[]internal.OutboundProvisioningConfiguration, idpName string) []internal.OutboundProvisioningConfiguration {
+	result := []internal.OutboundProvisioningConfiguration{}
+	if outboundIdps == nil {
+		return result
+	}
+	for _, outboundIdp := range *outboundIdps {
+		if outboundIdp.Idp == nil || *outboundIdp.Idp != idpName {
+			result = append(result, outboundIdp)
+		}
+	}
+	return result
+}
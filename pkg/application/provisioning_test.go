@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/asgardeo/go/pkg/identity_provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const provisionedApplication = `{"id": "app-id", "name": "Pickup", "provisioningConfigurations": {"outboundProvisioningIdps": [
+	{"idp": "Workspace", "connector": "googleapps", "blocking": false, "rules": false, "jit": false},
+	{"idp": "Directory", "connector": "scim", "blocking": false, "rules": false, "jit": false}
+]}}`
+
+// provisioningRoutes serves an application and a SCIM2 identity provider and records the patched application
+func provisioningRoutes(t *testing.T, connectorEnabled bool, patched *internal.ApplicationPatchModel) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `name eq "Directory"`, r.URL.Query().Get("filter"))
+			writeJSON(w, http.StatusOK, `{"identityProviders": [{"id": "idp-id", "name": "Directory"}]}`)
+		},
+		"GET /api/server/v1/identity-providers/idp-id/provisioning/outbound-connectors/c2NpbTI": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"connectorId": "c2NpbTI", "name": "scim2", "isEnabled": connectorEnabled})
+		},
+		"GET /api/server/v1/identity-providers/meta/outbound-provisioning-connectors/c2NpbTI": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"connectorId": "c2NpbTI", "name": "scim2"}`)
+		},
+		"GET /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, provisionedApplication)
+		},
+		"PATCH /api/server/v1/applications/app-id": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(patched))
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+}
+
+func TestEnableOutboundProvisioningReplacesExistingEntry(t *testing.T) {
+	var patched internal.ApplicationPatchModel
+	client := newTestClient(t, newTestServer(t, provisioningRoutes(t, true, &patched)))
+
+	config, err := client.EnableOutboundProvisioning(context.Background(), "app-id", "Directory", identity_provider.OutboundConnectorIDs.SCIM2,
+		&OutboundProvisioningOptionsModel{Blocking: true, JIT: true})
+	require.NoError(t, err)
+
+	expected := []OutboundProvisioningIdPModel{
+		{Idp: stringPtr("Workspace"), Connector: stringPtr("googleapps"), Blocking: boolPtr(false), Rules: boolPtr(false), Jit: boolPtr(false)},
+		{Idp: stringPtr("Directory"), Connector: stringPtr("scim2"), Blocking: boolPtr(true), Rules: boolPtr(false), Jit: boolPtr(true)},
+	}
+	assert.Equal(t, expected, *config.OutboundProvisioningIdps)
+	require.NotNil(t, patched.ProvisioningConfigurations)
+	assert.Equal(t, expected, *patched.ProvisioningConfigurations.OutboundProvisioningIdps)
+}
+
+func TestEnableOutboundProvisioningRequiresEnabledConnector(t *testing.T) {
+	var patched internal.ApplicationPatchModel
+	client := newTestClient(t, newTestServer(t, provisioningRoutes(t, false, &patched)))
+
+	_, err := client.EnableOutboundProvisioning(context.Background(), "app-id", "Directory", identity_provider.OutboundConnectorIDs.SCIM2, nil)
+	assert.EqualError(t, err, "outbound connector 'c2NpbTI' is not enabled on identity provider 'Directory'")
+	assert.Nil(t, patched.ProvisioningConfigurations, "the application must not be updated")
+}
+
+func TestDisableOutboundProvisioning(t *testing.T) {
+	var patched internal.ApplicationPatchModel
+	client := newTestClient(t, newTestServer(t, provisioningRoutes(t, true, &patched)))
+
+	config, err := client.DisableOutboundProvisioning(context.Background(), "app-id", "Workspace")
+	require.NoError(t, err)
+
+	expected := []OutboundProvisioningIdPModel{
+		{Idp: stringPtr("Directory"), Connector: stringPtr("scim"), Blocking: boolPtr(false), Rules: boolPtr(false), Jit: boolPtr(false)},
+	}
+	assert.Equal(t, expected, *config.OutboundProvisioningIdps)
+	require.NotNil(t, patched.ProvisioningConfigurations)
+	assert.Equal(t, expected, *patched.ProvisioningConfigurations.OutboundProvisioningIdps)
+}
+
+func TestDisableOutboundProvisioningForUnknownIdentityProvider(t *testing.T) {
+	var patched internal.ApplicationPatchModel
+	client := newTestClient(t, newTestServer(t, provisioningRoutes(t, true, &patched)))
+
+	config, err := client.DisableOutboundProvisioning(context.Background(), "app-id", "Unknown")
+	require.NoError(t, err)
+	assert.Len(t, *config.OutboundProvisioningIdps, 2)
+	assert.Nil(t, patched.ProvisioningConfigurations, "the application must not be updated")
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asgardeo/go/pkg/identity_provider"
+)
+
+// TokenExchangeGrantType is the RFC 8693 token exchange grant type
+const TokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+// EnableTokenExchange allows an application to exchange tokens issued by a trusted token issuer for Asgardeo tokens.
+// The issuer must be registered and enabled in the tenant; subject tokens must carry its issuer value and its alias
+// as the audience. The token exchange grant is added to the application's existing grant types.
+func (c *ApplicationClient) EnableTokenExchange(ctx context.Context, appId string, issuerName string) (*identity_provider.IdentityProviderListItemModel, error) {
+	identityProviderClient, err := identity_provider.New(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity provider client: %w", err)
+	}
+	issuer, err := identityProviderClient.GetTrustedTokenIssuerByName(ctx, issuerName)
+	if err != nil {
+		return nil, err
+	}
+	if issuer.IsEnabled != nil && !*issuer.IsEnabled {
+		return nil, fmt.Errorf("trusted token issuer '%s' is disabled", issuerName)
+	}
+
+	resp, err := c.apiClient.GetInboundOAuthConfigurationWithResponse(ctx, appId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing OAuth configuration: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, fmt.Errorf("failed to get existing OAuth configuration: status %d, body: %s",
+			resp.StatusCode(), string(resp.Body))
+	}
+
+	oauthConfig := *resp.JSON200
+	for _, grantType := range oauthConfig.GrantTypes {
+		if grantType == TokenExchangeGrantType {
+			return issuer, nil
+		}
+	}
+	oauthConfig.GrantTypes = append(oauthConfig.GrantTypes, TokenExchangeGrantType)
+
+	updateResp, err := c.apiClient.UpdateInboundOAuthConfigurationWithResponse(ctx, appId, oauthConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable token exchange: %w", err)
+	}
+	if updateResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to enable token exchange: status %d, body: %s",
+			updateResp.StatusCode(), string(updateResp.Body))
+	}
+	return issuer, nil
+}
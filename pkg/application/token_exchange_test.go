@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/application/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenExchangeRoutes serves a trusted token issuer and an application with the given grant types,
+// recording the updated OAuth configuration
+func tokenExchangeRoutes(t *testing.T, issuerEnabled bool, grantTypes string, updated **internal.OpenIDConnectConfiguration) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/trusted-token-issuers": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `name eq "Partner"`, r.URL.Query().Get("filter"))
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"identityProviders": []map[string]interface{}{{"id": "issuer-id", "name": "Partner", "isEnabled": issuerEnabled}},
+			})
+		},
+		"GET /api/server/v1/applications/app-id/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"clientId": "client-id", "grantTypes": `+grantTypes+`, "callbackURLs": ["https://pickup.example.com/callback"]}`)
+		},
+		"PUT /api/server/v1/applications/app-id/inbound-protocols/oidc": func(w http.ResponseWriter, r *http.Request) {
+			var config internal.OpenIDConnectConfiguration
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+			*updated = &config
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+}
+
+func TestEnableTokenExchangeKeepsExistingGrantTypes(t *testing.T) {
+	var updated *internal.OpenIDConnectConfiguration
+	client := newTestClient(t, newTestServer(t, tokenExchangeRoutes(t, true, `["authorization_code", "refresh_token"]`, &updated)))
+
+	issuer, err := client.EnableTokenExchange(context.Background(), "app-id", "Partner")
+	require.NoError(t, err)
+	assert.Equal(t, "issuer-id", *issuer.Id)
+
+	require.NotNil(t, updated)
+	assert.Equal(t, []string{"authorization_code", "refresh_token", TokenExchangeGrantType}, updated.GrantTypes)
+	assert.Equal(t, []string{"https://pickup.example.com/callback"}, *updated.CallbackURLs)
+}
+
+func TestEnableTokenExchangeDoesNotDuplicateGrantType(t *testing.T) {
+	var updated *internal.OpenIDConnectConfiguration
+	client := newTestClient(t, newTestServer(t, tokenExchangeRoutes(t, true, `["authorization_code", "`+TokenExchangeGrantType+`"]`, &updated)))
+
+	_, err := client.EnableTokenExchange(context.Background(), "app-id", "Partner")
+	require.NoError(t, err)
+	assert.Nil(t, updated, "the OAuth configuration must not be updated when token exchange is already enabled")
+}
+
+func TestEnableTokenExchangeRejectsDisabledIssuer(t *testing.T) {
+	var updated *internal.OpenIDConnectConfiguration
+	client := newTestClient(t, newTestServer(t, tokenExchangeRoutes(t, false, `["authorization_code"]`, &updated)))
+
+	_, err := client.EnableTokenExchange(context.Background(), "app-id", "Partner")
+	assert.EqualError(t, err, "trusted token issuer 'Partner' is disabled")
+	assert.Nil(t, updated)
+}
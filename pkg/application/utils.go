@@ -62,6 +62,28 @@ func splitPath(path string) string {
     return ""
 }
 
+// buildCallbackURLs returns the callback URL configuration for the given redirect URLs.
+// Multiple URLs are combined into a single "regexp=(callback1|callback2|...|callbackN)" entry.
+func buildCallbackURLs(callbackURLs []string) []string {
+	if len(callbackURLs) == 1 {
+		// If there's only one callback URL, use it directly without "regexp="
+		return callbackURLs
+	}
+
+	var callbackRegex string
+	for i, callback := range callbackURLs {
+		if i == 0 {
+			callbackRegex = fmt.Sprintf("regexp=(%s", callback)
+		} else {
+			callbackRegex = fmt.Sprintf("%s|%s", callbackRegex, callback)
+		}
+	}
+	if len(callbackURLs) > 0 {
+		callbackRegex = fmt.Sprintf("%s)", callbackRegex)
+	}
+	return []string{callbackRegex}
+}
+
 // Helper functions for creating pointers to primitive types
 func boolPtr(b bool) *bool {
     return &b
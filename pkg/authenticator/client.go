@@ -70,23 +70,98 @@ func (c *AuthenticatorClient) List(ctx context.Context, params *AuthenticatorLis
 }
 
 func (c *AuthenticatorClient) ListLocalAuthenticators(ctx context.Context) (*AuthenticatorListResponseModel, error) {
-	resp, err := c.apiClient.GetAuthenticatorsWithResponse(ctx, nil)
+	return c.ListByFilter(ctx, &AuthenticatorFilterModel{Type: AuthenticatorTypeLocal})
+}
+
+// ListByFilter lists the authenticators matching the given type, owner, tags and enabled state.
+// Tags are filtered by the server, and the remaining fields are matched on the returned list.
+func (c *AuthenticatorClient) ListByFilter(ctx context.Context, filter *AuthenticatorFilterModel) (*AuthenticatorListResponseModel, error) {
+	if filter == nil {
+		filter = &AuthenticatorFilterModel{}
+	}
+	params := &AuthenticatorListParamsModel{}
+	if len(filter.Tags) > 0 {
+		tagExpressions := make([]common.FilterExpression, 0, len(filter.Tags))
+		for _, tag := range filter.Tags {
+			tagExpressions = append(tagExpressions, common.Eq("tag", tag))
+		}
+		tagFilter := common.Or(tagExpressions...).String()
+		params.Filter = &tagFilter
+	}
+
+	resp, err := c.apiClient.GetAuthenticatorsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authenticators: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, fmt.Errorf("failed to list authenticators: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	authenticators := make([]AuthenticatorInfoResponseModel, 0)
+	for _, authenticator := range *resp.JSON200 {
+		if filter.matches(authenticator) {
+			authenticators = append(authenticators, authenticator)
+		}
+	}
+	return &authenticators, nil
+}
+
+// GetMetaTags lists the tags that can be used to filter authenticators.
+func (c *AuthenticatorClient) GetMetaTags(ctx context.Context) ([]string, error) {
+	resp, err := c.apiClient.GetAuthenticatorsMetaTagsWithResponse(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list local authenticators: %w", err)
+		return nil, fmt.Errorf("failed to get authenticator tags: %w", err)
 	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get authenticator tags: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	if resp.JSON200 == nil {
+		return []string{}, nil
+	}
+	return *resp.JSON200, nil
+}
 
+// GetConnectedApps lists the applications that use a local authenticator in their login flow.
+// Applications using a federated authenticator are listed through the connected apps of its identity provider.
+func (c *AuthenticatorClient) GetConnectedApps(ctx context.Context, authenticatorId string) (*ConnectedAppsResponseModel, error) {
+	resp, err := c.apiClient.GetConnectedAppsOfLocalAuthenticatorWithResponse(ctx, authenticatorId, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connected apps of authenticator: %w", err)
+	}
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to list local authenticators: %s", resp.Body)
+		return nil, fmt.Errorf("failed to get connected apps of authenticator: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetConnectedAppIds returns the IDs of the applications that use a local authenticator, which are the
+// applications affected when the authenticator is disabled or deleted.
+func (c *AuthenticatorClient) GetConnectedAppIds(ctx context.Context, authenticatorId string) ([]string, error) {
+	connectedApps, err := c.GetConnectedApps(ctx, authenticatorId)
+	if err != nil {
+		return nil, err
 	}
-	allAuthenticators := resp.JSON200
-	if allAuthenticators == nil {
-		return nil, fmt.Errorf("failed to list local authenticators: %s", resp.Body)
+	appIds := make([]string, 0)
+	if connectedApps == nil || connectedApps.ConnectedApps == nil {
+		return appIds, nil
 	}
-	localAuthenticators := make([]AuthenticatorInfoResponseModel, 0)
-	for _, authenticator := range *allAuthenticators {
-		if *authenticator.Type == "LOCAL" {
-			localAuthenticators = append(localAuthenticators, authenticator)
+	for _, app := range *connectedApps.ConnectedApps {
+		if app.AppId != nil {
+			appIds = append(appIds, *app.AppId)
 		}
 	}
-	return &localAuthenticators, nil
+	return appIds, nil
+}
+
+func (f *AuthenticatorFilterModel) matches(authenticator AuthenticatorInfoResponseModel) bool {
+	if f.Type != "" && (authenticator.Type == nil || *authenticator.Type != f.Type) {
+		return false
+	}
+	if f.DefinedBy != "" && (authenticator.DefinedBy == nil || *authenticator.DefinedBy != f.DefinedBy) {
+		return false
+	}
+	if f.IsEnabled != nil && (authenticator.IsEnabled == nil || *authenticator.IsEnabled != *f.IsEnabled) {
+		return false
+	}
+	return true
 }
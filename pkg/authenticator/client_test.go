@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const authenticatorsResponse = `[
+	{"id":"QmFzaWNBdXRoZW50aWNhdG9y","name":"BasicAuthenticator","type":"LOCAL","definedBy":"SYSTEM","isEnabled":true,"tags":["Username-Password"]},
+	{"id":"dG90cA","name":"totp","type":"LOCAL","definedBy":"SYSTEM","isEnabled":false,"tags":["MFA"]},
+	{"id":"cGluLWF1dGg","name":"pin-auth","type":"LOCAL","definedBy":"USER","isEnabled":true,"tags":["Custom"]},
+	{"id":"Z29vZ2xl","name":"Google","type":"FEDERATED","definedBy":"SYSTEM","isEnabled":true,"tags":["Social-Login"]},
+	{"id":"bGVnYWN5","name":"legacy"}
+]`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *AuthenticatorClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func authenticatorNames(authenticators *AuthenticatorListResponseModel) []string {
+	names := make([]string, 0)
+	for _, authenticator := range *authenticators {
+		names = append(names, *authenticator.Name)
+	}
+	return names
+}
+
+func TestListByFilter(t *testing.T) {
+	var receivedFilter string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedFilter = r.URL.Query().Get("filter")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, authenticatorsResponse)
+	})
+	enabled := true
+
+	tests := []struct {
+		name     string
+		filter   *AuthenticatorFilterModel
+		expected []string
+	}{
+		{"all", nil, []string{"BasicAuthenticator", "totp", "pin-auth", "Google", "legacy"}},
+		{"federated", &AuthenticatorFilterModel{Type: AuthenticatorTypeFederated}, []string{"Google"}},
+		{"custom", &AuthenticatorFilterModel{DefinedBy: DefinedByUser}, []string{"pin-auth"}},
+		{"enabled local", &AuthenticatorFilterModel{Type: AuthenticatorTypeLocal, IsEnabled: &enabled}, []string{"BasicAuthenticator", "pin-auth"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticators, err := client.ListByFilter(context.Background(), tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, authenticatorNames(authenticators))
+			assert.Empty(t, receivedFilter)
+		})
+	}
+
+	_, err := client.ListByFilter(context.Background(), &AuthenticatorFilterModel{Tags: []string{TagMFA, TagPasswordless}})
+	require.NoError(t, err)
+	assert.Equal(t, `tag eq "MFA" or tag eq "Passwordless"`, receivedFilter)
+}
+
+func TestListLocalAuthenticatorsSkipsMissingType(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, authenticatorsResponse)
+	})
+
+	authenticators, err := client.ListLocalAuthenticators(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BasicAuthenticator", "totp", "pin-auth"}, authenticatorNames(authenticators))
+}
+
+func TestGetConnectedAppIds(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/server/v1/authenticators/dG90cA/connected-apps", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"totalResults":2,"connectedApps":[{"appId":"app-1","self":"/applications/app-1"},{"appId":"app-2"}]}`)
+	})
+
+	appIds, err := client.GetConnectedAppIds(context.Background(), "dG90cA")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app-1", "app-2"}, appIds)
+}
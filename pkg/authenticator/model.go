@@ -27,3 +27,98 @@ type AuthenticatorListParamsModel = internal.GetAuthenticatorsParams
 type AuthenticatorListResponseModel = internal.Authenticators
 
 type AuthenticatorInfoResponseModel = internal.Authenticator
+
+// UserDefinedAuthenticationType defines whether a user-defined authenticator identifies users or verifies
+// users identified in an earlier step
+type UserDefinedAuthenticationType = internal.UserDefinedLocalAuthenticatorCreationAuthenticationType
+
+// User-defined authenticator authentication types
+const (
+	AuthenticationTypeIdentification UserDefinedAuthenticationType = internal.IDENTIFICATION
+	AuthenticationTypeVerification   UserDefinedAuthenticationType = internal.VERIFICATION
+)
+
+// EndpointAuthType is how Asgardeo authenticates to the external service of a user-defined authenticator
+type EndpointAuthType = internal.AuthenticationTypeType
+
+// Endpoint authentication types
+const (
+	EndpointAuthBasic  EndpointAuthType = internal.BASIC
+	EndpointAuthBearer EndpointAuthType = internal.BEARER
+	EndpointAuthAPIKey EndpointAuthType = internal.APIKEY
+	EndpointAuthNone   EndpointAuthType = internal.NONE
+)
+
+// EndpointAuthModel holds the credentials Asgardeo uses to call the external service of a user-defined
+// authenticator. Use BasicAuth, BearerAuth, APIKeyAuth or NoAuth to build it.
+// Secrets are masked when the model is printed, logged or encoded as JSON.
+type EndpointAuthModel struct {
+	Type EndpointAuthType
+	// Username and Password are used with BASIC authentication
+	Username string
+	Password string
+	// AccessToken is used with BEARER authentication
+	AccessToken string
+	// Header and Value are the header name and API key used with API_KEY authentication
+	Header string
+	Value  string
+}
+
+// UserDefinedAuthenticatorModel defines a local authenticator backed by an external service
+type UserDefinedAuthenticatorModel struct {
+	// Name is the identifier of the authenticator and cannot be changed after creation
+	Name        string
+	DisplayName string
+	Description string
+	Image       string
+	IsEnabled   bool
+	// AuthenticationType cannot be changed after creation and defaults to IDENTIFICATION
+	AuthenticationType UserDefinedAuthenticationType
+	// EndpointURI is the https URL of the external service
+	EndpointURI  string
+	EndpointAuth EndpointAuthModel
+}
+
+// AuthenticatorType is whether an authenticator is local or federated
+type AuthenticatorType = internal.AuthenticatorType
+
+// Authenticator types
+const (
+	AuthenticatorTypeLocal     AuthenticatorType = internal.LOCAL
+	AuthenticatorTypeFederated AuthenticatorType = internal.FEDERATED
+)
+
+// AuthenticatorDefinedBy is whether an authenticator is built in or user-defined
+type AuthenticatorDefinedBy = internal.AuthenticatorDefinedBy
+
+// Authenticator owners
+const (
+	DefinedBySystem AuthenticatorDefinedBy = internal.SYSTEM
+	DefinedByUser   AuthenticatorDefinedBy = internal.USER
+)
+
+// Common authenticator tags. GetMetaTags lists the tags supported by the server.
+const (
+	TagMFA              = "MFA"
+	TagPasswordless     = "Passwordless"
+	TagSocialLogin      = "Social-Login"
+	TagEnterprise       = "Enterprise"
+	TagAPIAuth          = "APIAuth"
+	TagRequestPath      = "Request-Path"
+	TagUsernamePassword = "Username-Password"
+	TagCustom           = "Custom"
+)
+
+// AuthenticatorFilterModel narrows down the authenticators returned by ListByFilter. Empty fields match all.
+type AuthenticatorFilterModel struct {
+	Type AuthenticatorType
+	// DefinedBy selects built-in (SYSTEM) or custom (USER) authenticators
+	DefinedBy AuthenticatorDefinedBy
+	// Tags matches authenticators with any of the given tags
+	Tags      []string
+	IsEnabled *bool
+}
+
+type ConnectedAppsResponseModel = internal.ConnectedApps
+
+type ConnectedAppModel = internal.ConnectedApp
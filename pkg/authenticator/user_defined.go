@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authenticator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/authenticator/internal"
+)
+
+const maskedSecret = "********"
+
+// BasicAuth authenticates to the external service with a username and password
+func BasicAuth(username string, password string) EndpointAuthModel {
+	return EndpointAuthModel{Type: EndpointAuthBasic, Username: username, Password: password}
+}
+
+// BearerAuth authenticates to the external service with a bearer access token
+func BearerAuth(accessToken string) EndpointAuthModel {
+	return EndpointAuthModel{Type: EndpointAuthBearer, AccessToken: accessToken}
+}
+
+// APIKeyAuth authenticates to the external service with an API key sent in the given header
+func APIKeyAuth(header string, value string) EndpointAuthModel {
+	return EndpointAuthModel{Type: EndpointAuthAPIKey, Header: header, Value: value}
+}
+
+// NoAuth calls the external service without authentication
+func NoAuth() EndpointAuthModel {
+	return EndpointAuthModel{Type: EndpointAuthNone}
+}
+
+// Create creates a user-defined local authenticator backed by an external service.
+func (c *AuthenticatorClient) Create(ctx context.Context, authenticator *UserDefinedAuthenticatorModel) (*AuthenticatorInfoResponseModel, error) {
+	if authenticator == nil || authenticator.Name == "" {
+		return nil, fmt.Errorf("authenticator name is required")
+	}
+	endpoint, err := buildEndpoint(authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticationType := authenticator.AuthenticationType
+	if authenticationType == "" {
+		authenticationType = AuthenticationTypeIdentification
+	}
+	request := internal.UserDefinedLocalAuthenticatorCreation{
+		Name:               authenticator.Name,
+		DisplayName:        authenticator.DisplayName,
+		IsEnabled:          authenticator.IsEnabled,
+		AuthenticationType: &authenticationType,
+		Endpoint:           endpoint,
+	}
+	if authenticator.Description != "" {
+		request.Description = &authenticator.Description
+	}
+	if authenticator.Image != "" {
+		request.Image = &authenticator.Image
+	}
+
+	resp, err := c.apiClient.AddUserDefinedLocalAuthenticatorWithResponse(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %s", redactSecrets(err.Error(), authenticator.EndpointAuth))
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create authenticator: status %d, body: %s", resp.StatusCode(),
+			redactSecrets(string(resp.Body), authenticator.EndpointAuth))
+	}
+	return resp.JSON201, nil
+}
+
+// Update updates a user-defined local authenticator. The name and authentication type cannot be changed.
+func (c *AuthenticatorClient) Update(ctx context.Context, id string, authenticator *UserDefinedAuthenticatorModel) (*AuthenticatorInfoResponseModel, error) {
+	if authenticator == nil {
+		return nil, fmt.Errorf("authenticator is required")
+	}
+	endpoint, err := buildEndpoint(authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	request := internal.UserDefinedLocalAuthenticatorUpdate{
+		DisplayName: authenticator.DisplayName,
+		IsEnabled:   authenticator.IsEnabled,
+		Endpoint:    endpoint,
+	}
+	if authenticator.Description != "" {
+		request.Description = &authenticator.Description
+	}
+	if authenticator.Image != "" {
+		request.Image = &authenticator.Image
+	}
+
+	resp, err := c.apiClient.UpdateUserDefinedLocalAuthenticatorWithResponse(ctx, id, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update authenticator: %s", redactSecrets(err.Error(), authenticator.EndpointAuth))
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update authenticator: status %d, body: %s", resp.StatusCode(),
+			redactSecrets(string(resp.Body), authenticator.EndpointAuth))
+	}
+	return resp.JSON200, nil
+}
+
+// Delete deletes a user-defined local authenticator.
+func (c *AuthenticatorClient) Delete(ctx context.Context, id string) error {
+	resp, err := c.apiClient.DeleteUserDefinedLocalAuthenticatorWithResponse(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete authenticator: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete authenticator: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// Validate checks that the credentials required by the authentication type are set
+func (m EndpointAuthModel) Validate() error {
+	switch m.Type {
+	case EndpointAuthBasic:
+		if m.Username == "" || m.Password == "" {
+			return fmt.Errorf("username and password are required for BASIC endpoint authentication")
+		}
+	case EndpointAuthBearer:
+		if m.AccessToken == "" {
+			return fmt.Errorf("access token is required for BEARER endpoint authentication")
+		}
+	case EndpointAuthAPIKey:
+		if m.Header == "" || m.Value == "" {
+			return fmt.Errorf("header and value are required for API_KEY endpoint authentication")
+		}
+	case EndpointAuthNone:
+	default:
+		return fmt.Errorf("unsupported endpoint authentication type '%s'", m.Type)
+	}
+	return nil
+}
+
+// String renders the endpoint authentication with secrets masked
+func (m EndpointAuthModel) String() string {
+	switch m.Type {
+	case EndpointAuthBasic:
+		return fmt.Sprintf("{Type:%s Username:%s Password:%s}", m.Type, m.Username, mask(m.Password))
+	case EndpointAuthBearer:
+		return fmt.Sprintf("{Type:%s AccessToken:%s}", m.Type, mask(m.AccessToken))
+	case EndpointAuthAPIKey:
+		return fmt.Sprintf("{Type:%s Header:%s Value:%s}", m.Type, m.Header, mask(m.Value))
+	default:
+		return fmt.Sprintf("{Type:%s}", m.Type)
+	}
+}
+
+// GoString renders the endpoint authentication for %#v with secrets masked
+func (m EndpointAuthModel) GoString() string {
+	return "authenticator.EndpointAuthModel" + m.String()
+}
+
+// MarshalJSON encodes the endpoint authentication with secrets masked, which also masks them when a
+// UserDefinedAuthenticatorModel is encoded
+func (m EndpointAuthModel) MarshalJSON() ([]byte, error) {
+	type endpointAuth EndpointAuthModel
+	masked := endpointAuth(m)
+	masked.Password = mask(m.Password)
+	masked.AccessToken = mask(m.AccessToken)
+	masked.Value = mask(m.Value)
+	return json.Marshal(masked)
+}
+
+// LogValue renders the endpoint authentication for structured logging with secrets masked
+func (m EndpointAuthModel) LogValue() slog.Value {
+	attributes := []slog.Attr{slog.String("type", string(m.Type))}
+	switch m.Type {
+	case EndpointAuthBasic:
+		attributes = append(attributes, slog.String("username", m.Username), slog.String("password", mask(m.Password)))
+	case EndpointAuthBearer:
+		attributes = append(attributes, slog.String("accessToken", mask(m.AccessToken)))
+	case EndpointAuthAPIKey:
+		attributes = append(attributes, slog.String("header", m.Header), slog.String("value", mask(m.Value)))
+	}
+	return slog.GroupValue(attributes...)
+}
+
+// LogValue renders the authenticator for structured logging with endpoint secrets masked
+func (m UserDefinedAuthenticatorModel) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", m.Name),
+		slog.String("displayName", m.DisplayName),
+		slog.Bool("isEnabled", m.IsEnabled),
+		slog.String("authenticationType", string(m.AuthenticationType)),
+		slog.String("endpointURI", m.EndpointURI),
+		slog.Any("endpointAuth", m.EndpointAuth),
+	)
+}
+
+// buildEndpoint validates the display name, endpoint URI and authentication of an authenticator
+func buildEndpoint(authenticator *UserDefinedAuthenticatorModel) (internal.Endpoint, error) {
+	if authenticator.DisplayName == "" {
+		return internal.Endpoint{}, fmt.Errorf("authenticator display name is required")
+	}
+	endpointURL, err := url.Parse(authenticator.EndpointURI)
+	if err != nil || endpointURL.Scheme != "https" || endpointURL.Host == "" {
+		return internal.Endpoint{}, fmt.Errorf("endpoint URI of authenticator '%s' must be an absolute https URL", authenticator.DisplayName)
+	}
+	if endpointURL.User != nil {
+		return internal.Endpoint{}, fmt.Errorf("endpoint URI of authenticator '%s' must not contain credentials", authenticator.DisplayName)
+	}
+	if err := authenticator.EndpointAuth.Validate(); err != nil {
+		return internal.Endpoint{}, err
+	}
+
+	properties := map[string]interface{}{}
+	auth := authenticator.EndpointAuth
+	switch auth.Type {
+	case EndpointAuthBasic:
+		properties["username"] = auth.Username
+		properties["password"] = auth.Password
+	case EndpointAuthBearer:
+		properties["accessToken"] = auth.AccessToken
+	case EndpointAuthAPIKey:
+		properties["header"] = auth.Header
+		properties["value"] = auth.Value
+	}
+	uri := authenticator.EndpointURI
+	return internal.Endpoint{
+		Uri: &uri,
+		Authentication: &internal.AuthenticationType{
+			Type:       auth.Type,
+			Properties: properties,
+		},
+	}, nil
+}
+
+// redactSecrets removes endpoint secrets from text that may echo the request, such as error responses.
+// Secrets are also removed in their JSON escaped forms, as they appear in echoed request bodies.
+func redactSecrets(text string, auth EndpointAuthModel) string {
+	for _, secret := range []string{auth.Password, auth.AccessToken, auth.Value} {
+		if secret == "" {
+			continue
+		}
+		for _, encoded := range jsonEscapedForms(secret) {
+			text = strings.ReplaceAll(text, encoded, maskedSecret)
+		}
+		text = strings.ReplaceAll(text, secret, maskedSecret)
+	}
+	return text
+}
+
+// jsonEscapedForms returns a value as escaped inside a JSON string, with and without HTML escaping
+func jsonEscapedForms(value string) []string {
+	var forms []string
+	for _, escapeHTML := range []bool{true, false} {
+		var encoded bytes.Buffer
+		encoder := json.NewEncoder(&encoded)
+		encoder.SetEscapeHTML(escapeHTML)
+		if err := encoder.Encode(value); err != nil {
+			continue
+		}
+		// Strip the quotes and the newline written by the encoder
+		form := strings.TrimSuffix(encoded.String(), "\n")
+		form = form[1 : len(form)-1]
+		if form != value {
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+func mask(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return maskedSecret
+}
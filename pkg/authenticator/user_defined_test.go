@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserDefinedAuthenticator(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.Header().Set("Content-Type", "application/json")
+		if received["displayName"] == "Broken" {
+			// Echo the request the way a misbehaving validation error might
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(body)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"id":"cGluLWF1dGg","name":"pin-auth"}`)
+	}))
+	defer server.Close()
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+
+	authenticator := &UserDefinedAuthenticatorModel{
+		Name:         "pin-auth",
+		DisplayName:  "PIN",
+		IsEnabled:    true,
+		EndpointURI:  "https://auth.example.com/pin",
+		EndpointAuth: APIKeyAuth("X-API-Key", "k3y-s3cret"),
+	}
+	created, err := client.Create(context.Background(), authenticator)
+	require.NoError(t, err)
+	assert.Equal(t, "cGluLWF1dGg", *created.Id)
+	assert.Equal(t, "IDENTIFICATION", received["authenticationType"])
+	assert.Equal(t, map[string]interface{}{
+		"uri": "https://auth.example.com/pin",
+		"authentication": map[string]interface{}{
+			"type":       "API_KEY",
+			"properties": map[string]interface{}{"header": "X-API-Key", "value": "k3y-s3cret"},
+		},
+	}, received["endpoint"])
+
+	authenticator.DisplayName = "Broken"
+	_, err = client.Create(context.Background(), authenticator)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 400")
+	assert.NotContains(t, err.Error(), "k3y-s3cret")
+}
+
+func TestUserDefinedAuthenticatorValidation(t *testing.T) {
+	client, err := New(config.DefaultClientConfig().WithBaseURL("https://localhost").WithToken("test-token"))
+	require.NoError(t, err)
+
+	_, err = client.Create(context.Background(), &UserDefinedAuthenticatorModel{
+		Name: "pin-auth", DisplayName: "PIN", EndpointURI: "http://auth.example.com/pin", EndpointAuth: NoAuth(),
+	})
+	assert.EqualError(t, err, "endpoint URI of authenticator 'PIN' must be an absolute https URL")
+
+	_, err = client.Create(context.Background(), &UserDefinedAuthenticatorModel{
+		Name: "pin-auth", DisplayName: "PIN", EndpointURI: "https://auth.example.com/pin", EndpointAuth: BasicAuth("svc", ""),
+	})
+	assert.EqualError(t, err, "username and password are required for BASIC endpoint authentication")
+}
+
+func TestEndpointAuthMasksSecrets(t *testing.T) {
+	for _, auth := range []EndpointAuthModel{BasicAuth("svc", "p4ss"), BearerAuth("p4ss"), APIKeyAuth("X-API-Key", "p4ss")} {
+		authenticator := UserDefinedAuthenticatorModel{Name: "pin-auth", EndpointAuth: auth}
+		for _, rendered := range []string{
+			fmt.Sprint(auth), fmt.Sprintf("%#v", auth), fmt.Sprintf("%+v", authenticator), fmt.Sprintf("%#v", authenticator),
+		} {
+			assert.NotContains(t, rendered, "p4ss")
+			assert.Contains(t, rendered, maskedSecret)
+		}
+		for _, value := range []interface{}{auth, authenticator} {
+			encoded, err := json.Marshal(value)
+			require.NoError(t, err)
+			assert.NotContains(t, string(encoded), "p4ss")
+			assert.Contains(t, string(encoded), maskedSecret)
+		}
+	}
+}
+
+func TestRedactSecretsRemovesJSONEscapedSecrets(t *testing.T) {
+	auth := BasicAuth("svc", `p4ss"<\word>`)
+	echoed, err := json.Marshal(map[string]string{"password": auth.Password})
+	require.NoError(t, err)
+
+	// The echoed secret may be JSON escaped with or without HTML escaping
+	redacted := redactSecrets(`{"password":"p4ss\"<\\word>"} `+string(echoed)+" "+auth.Password, auth)
+	assert.Equal(t, `{"password":"`+maskedSecret+`"} {"password":"`+maskedSecret+`"} `+maskedSecret, redacted)
+}
@@ -18,8 +18,15 @@
 
 package claim
 
+import "github.com/asgardeo/go/pkg/claim/wellknown"
+
 var ClaimDialectIDs = struct {
-	OIDC string
+	Local string
+	OIDC  string
 }{
-	OIDC: "aHR0cDovL3dzbzIub3JnL29pZGMvY2xhaW0",
+	Local: wellknown.DialectIDLocal,
+	OIDC:  wellknown.DialectIDOIDC,
 }
+
+// LocalDialectURI is the URI of the dialect of local claims
+const LocalDialectURI = wellknown.DialectLocal
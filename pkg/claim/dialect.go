@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/claim/internal"
+)
+
+// DialectIDFromURI computes the ID of a claim dialect from its URI, which is the URI encoded as base64url
+// without padding. The local dialect always has the ID "local".
+func DialectIDFromURI(dialectURI string) string {
+	if dialectURI == LocalDialectURI {
+		return ClaimDialectIDs.Local
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(dialectURI))
+}
+
+// ClaimIDFromURI computes the ID of a local or external claim from its claim URI, using the same encoding as
+// dialect IDs.
+func ClaimIDFromURI(claimURI string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(claimURI))
+}
+
+// ListClaimDialects lists the claim dialects, including the local dialect.
+func (c *ClaimClient) ListClaimDialects(ctx context.Context) (*[]ClaimDialectResponseModel, error) {
+	resp, err := c.apiClient.GetClaimDialectsWithResponse(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claim dialects: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to list claim dialects: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetClaimDialect retrieves a claim dialect by ID.
+func (c *ClaimClient) GetClaimDialect(ctx context.Context, dialectId string) (*ClaimDialectResponseModel, error) {
+	resp, err := c.apiClient.GetClaimDialectWithResponse(ctx, dialectId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claim dialect: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get claim dialect: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// CreateClaimDialect adds a custom claim dialect and returns its ID.
+func (c *ClaimClient) CreateClaimDialect(ctx context.Context, dialectURI string) (string, error) {
+	if err := validateDialectURI(dialectURI); err != nil {
+		return "", err
+	}
+	resp, err := c.apiClient.AddClaimDialectWithResponse(ctx, internal.ClaimDialectReq{DialectURI: dialectURI})
+	if err != nil {
+		return "", fmt.Errorf("failed to create claim dialect: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("failed to create claim dialect: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	dialectId, err := createdResourceId(resp.HTTPResponse)
+	if err != nil {
+		return "", fmt.Errorf("failed to create claim dialect: %w", err)
+	}
+	return dialectId, nil
+}
+
+// UpdateClaimDialect changes the URI of a custom claim dialect. The ID is derived from the URI, so the new ID
+// of the dialect is returned.
+func (c *ClaimClient) UpdateClaimDialect(ctx context.Context, dialectId string, dialectURI string) (string, error) {
+	if dialectId == ClaimDialectIDs.Local {
+		return "", fmt.Errorf("the local claim dialect cannot be updated")
+	}
+	if err := validateDialectURI(dialectURI); err != nil {
+		return "", err
+	}
+	resp, err := c.apiClient.UpdateClaimDialectWithResponse(ctx, dialectId, internal.ClaimDialectReq{DialectURI: dialectURI})
+	if err != nil {
+		return "", fmt.Errorf("failed to update claim dialect: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", fmt.Errorf("failed to update claim dialect: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return DialectIDFromURI(dialectURI), nil
+}
+
+// DeleteClaimDialect deletes a custom claim dialect together with its external claims.
+func (c *ClaimClient) DeleteClaimDialect(ctx context.Context, dialectId string) error {
+	if dialectId == ClaimDialectIDs.Local {
+		return fmt.Errorf("the local claim dialect cannot be deleted")
+	}
+	resp, err := c.apiClient.DeleteClaimDialectWithResponse(ctx, dialectId)
+	if err != nil {
+		return fmt.Errorf("failed to delete claim dialect: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete claim dialect: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// GetExternalClaim retrieves an external claim of a dialect by ID.
+func (c *ClaimClient) GetExternalClaim(ctx context.Context, dialectId string, claimId string) (*ExternalClaimResponseModel, error) {
+	resp, err := c.apiClient.GetExternalClaimWithResponse(ctx, dialectId, claimId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get external claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// CreateExternalClaim adds an external claim mapped to a local claim and returns its ID.
+func (c *ClaimClient) CreateExternalClaim(ctx context.Context, dialectId string, claim *ExternalClaimModel) (string, error) {
+	if err := validateExternalClaim(dialectId, claim); err != nil {
+		return "", err
+	}
+	resp, err := c.apiClient.AddExternalClaimWithResponse(ctx, dialectId, *claim)
+	if err != nil {
+		return "", fmt.Errorf("failed to create external claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("failed to create external claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	claimId, err := createdResourceId(resp.HTTPResponse)
+	if err != nil {
+		return "", fmt.Errorf("failed to create external claim: %w", err)
+	}
+	return claimId, nil
+}
+
+// UpdateExternalClaim changes the local claim an external claim is mapped to. The claim URI cannot be changed.
+func (c *ClaimClient) UpdateExternalClaim(ctx context.Context, dialectId string, claimId string, claim *ExternalClaimModel) error {
+	if err := validateExternalClaim(dialectId, claim); err != nil {
+		return err
+	}
+	resp, err := c.apiClient.UpdateExternalClaimWithResponse(ctx, dialectId, claimId, *claim)
+	if err != nil {
+		return fmt.Errorf("failed to update external claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update external claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// DeleteExternalClaim deletes an external claim of a dialect.
+func (c *ClaimClient) DeleteExternalClaim(ctx context.Context, dialectId string, claimId string) error {
+	resp, err := c.apiClient.DeleteExternalClaimWithResponse(ctx, dialectId, claimId)
+	if err != nil {
+		return fmt.Errorf("failed to delete external claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete external claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+func validateDialectURI(dialectURI string) error {
+	if dialectURI == "" {
+		return fmt.Errorf("claim dialect URI is required")
+	}
+	if dialectURI == LocalDialectURI {
+		return fmt.Errorf("claim dialect URI '%s' is reserved for local claims", dialectURI)
+	}
+	return nil
+}
+
+func validateExternalClaim(dialectId string, claim *ExternalClaimModel) error {
+	if dialectId == ClaimDialectIDs.Local {
+		return fmt.Errorf("external claims cannot be added to the local claim dialect")
+	}
+	if claim == nil || claim.ClaimURI == "" {
+		return fmt.Errorf("external claim URI is required")
+	}
+	if !strings.HasPrefix(claim.MappedLocalClaimURI, LocalDialectURI+"/") {
+		return fmt.Errorf("external claim '%s' must be mapped to a local claim, got '%s'", claim.ClaimURI, claim.MappedLocalClaimURI)
+	}
+	return nil
+}
+
+// createdResourceId returns the ID of a created resource, which is only referenced by the Location header
+func createdResourceId(resp *http.Response) (string, error) {
+	location := ""
+	if resp != nil {
+		location = resp.Header.Get("Location")
+	}
+	if location == "" {
+		return "", fmt.Errorf("missing location of created resource")
+	}
+	return path.Base(strings.TrimSuffix(location, "/")), nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialectIDFromURI(t *testing.T) {
+	assert.Equal(t, ClaimDialectIDs.OIDC, DialectIDFromURI("http://wso2.org/oidc/claim"))
+	assert.Equal(t, ClaimDialectIDs.Local, DialectIDFromURI(LocalDialectURI))
+	assert.Equal(t, "dXJuOmV4YW1wbGU6cGFydG5lcg", DialectIDFromURI("urn:example:partner"))
+	assert.Equal(t, "aHR0cDovL3dzbzIub3JnL2NsYWltcy9lbXBsb3llZUlk", ClaimIDFromURI("http://wso2.org/claims/employeeId"))
+}
+
+func TestCreateExternalClaim(t *testing.T) {
+	var received ExternalClaimModel
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/server/v1/claim-dialects/dXJuOmV4YW1wbGU6cGFydG5lcg/claims", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.Header().Set("Location", "/api/server/v1/claim-dialects/dXJuOmV4YW1wbGU6cGFydG5lcg/claims/ZW1wX2lk")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	claimId, err := client.CreateExternalClaim(context.Background(), DialectIDFromURI("urn:example:partner"), &ExternalClaimModel{
+		ClaimURI:            "emp_id",
+		MappedLocalClaimURI: "http://wso2.org/claims/employeeId",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ZW1wX2lk", claimId)
+	assert.Equal(t, "http://wso2.org/claims/employeeId", received.MappedLocalClaimURI)
+}
+
+func TestDialectValidation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	ctx := context.Background()
+
+	_, err := client.CreateClaimDialect(ctx, LocalDialectURI)
+	assert.ErrorContains(t, err, "reserved")
+	assert.ErrorContains(t, client.DeleteClaimDialect(ctx, ClaimDialectIDs.Local), "cannot be deleted")
+	_, err = client.CreateExternalClaim(ctx, ClaimDialectIDs.OIDC, &ExternalClaimModel{ClaimURI: "tier", MappedLocalClaimURI: "http://wso2.org/oidc/claim/tier"})
+	assert.ErrorContains(t, err, "must be mapped to a local claim")
+	_, err = client.CreateExternalClaim(ctx, ClaimDialectIDs.Local, &ExternalClaimModel{ClaimURI: "tier", MappedLocalClaimURI: "http://wso2.org/claims/tier"})
+	assert.ErrorContains(t, err, "local claim dialect")
+}
+
+func TestCreateClaimDialect(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/server/v1/claim-dialects", r.URL.Path)
+		w.Header().Set("Location", "/api/server/v1/claim-dialects/dXJuOmV4YW1wbGU6cGFydG5lcg")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	dialectId, err := client.CreateClaimDialect(context.Background(), "urn:example:partner")
+	require.NoError(t, err)
+	assert.Equal(t, "dXJuOmV4YW1wbGU6cGFydG5lcg", dialectId)
+}
+
+func TestCreateDialectResourcesRequireLocation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	ctx := context.Background()
+
+	_, err := client.CreateClaimDialect(ctx, "urn:example:partner")
+	assert.EqualError(t, err, "failed to create claim dialect: missing location of created resource")
+	_, err = client.CreateExternalClaim(ctx, DialectIDFromURI("urn:example:partner"), &ExternalClaimModel{
+		ClaimURI:            "emp_id",
+		MappedLocalClaimURI: "http://wso2.org/claims/employeeId",
+	})
+	assert.EqualError(t, err, "failed to create external claim: missing location of created resource")
+}
@@ -0,0 +1,323 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/claim/internal"
+	"github.com/asgardeo/go/pkg/common"
+)
+
+// CreateLocalClaim adds a local claim and returns the ID of the created claim.
+func (c *ClaimClient) CreateLocalClaim(ctx context.Context, claim *LocalClaimModel) (string, error) {
+	if err := ValidateLocalClaim(claim); err != nil {
+		return "", err
+	}
+	resp, err := c.apiClient.AddLocalClaimWithResponse(ctx, buildLocalClaimRequest(claim))
+	if err != nil {
+		return "", fmt.Errorf("failed to create local claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("failed to create local claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	// The created claim is only referenced by the Location header
+	location := resp.HTTPResponse.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("failed to create local claim: missing location of created claim")
+	}
+	return path.Base(strings.TrimSuffix(location, "/")), nil
+}
+
+// GetLocalClaim retrieves a local claim by ID.
+func (c *ClaimClient) GetLocalClaim(ctx context.Context, claimId string) (*LocalClaimResponseModel, error) {
+	resp, err := c.apiClient.GetLocalClaimWithResponse(ctx, claimId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get local claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get local claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateLocalClaim replaces a local claim. The claim URI cannot be changed.
+func (c *ClaimClient) UpdateLocalClaim(ctx context.Context, claimId string, claim *LocalClaimModel) error {
+	if err := ValidateLocalClaim(claim); err != nil {
+		return err
+	}
+	return c.updateLocalClaim(ctx, claimId, buildLocalClaimRequest(claim))
+}
+
+// DeleteLocalClaim deletes a local claim. Claims mapped by external claims must be unmapped first.
+func (c *ClaimClient) DeleteLocalClaim(ctx context.Context, claimId string) error {
+	resp, err := c.apiClient.DeleteLocalClaimWithResponse(ctx, claimId)
+	if err != nil {
+		return fmt.Errorf("failed to delete local claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete local claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// EnsureLocalClaim creates a local claim if none exists with the given claim URI, otherwise updates only the
+// fields that differ from the desired state. Properties are left unchanged when nil, and attribute profiles
+// and uniqueness settings of an existing claim are always preserved.
+func (c *ClaimClient) EnsureLocalClaim(ctx context.Context, claim *LocalClaimModel) (*LocalClaimEnsureResultModel, error) {
+	if err := ValidateLocalClaim(claim); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findLocalClaimByURI(ctx, claim.ClaimURI)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		claimId, err := c.CreateLocalClaim(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		created, err := c.GetLocalClaim(ctx, claimId)
+		if err != nil {
+			return nil, err
+		}
+		return &LocalClaimEnsureResultModel{Action: common.EnsureActionCreated, Claim: created}, nil
+	}
+
+	changes := diffLocalClaim(existing, claim)
+	if len(changes) == 0 {
+		return &LocalClaimEnsureResultModel{Action: common.EnsureActionUnchanged, Claim: existing}, nil
+	}
+
+	request := buildLocalClaimRequest(claim)
+	request.Profiles = existing.Profiles
+	if existing.SharedProfileValueResolvingMethod != nil {
+		method := internal.LocalClaimReqSharedProfileValueResolvingMethod(*existing.SharedProfileValueResolvingMethod)
+		request.SharedProfileValueResolvingMethod = &method
+	}
+	if existing.UniquenessScope != nil {
+		scope := internal.LocalClaimReqUniquenessScope(*existing.UniquenessScope)
+		request.UniquenessScope = &scope
+	}
+	if claim.Properties == nil {
+		request.Properties = existing.Properties
+	}
+
+	claimId := stringValue(existing.Id)
+	if err := c.updateLocalClaim(ctx, claimId, request); err != nil {
+		return nil, err
+	}
+	updated, err := c.GetLocalClaim(ctx, claimId)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalClaimEnsureResultModel{Action: common.EnsureActionUpdated, Claim: updated, Changes: changes}, nil
+}
+
+// ValidateLocalClaim checks that a local claim has a claim URI in the local dialect, a display name and
+// one attribute mapping per user store.
+func ValidateLocalClaim(claim *LocalClaimModel) error {
+	if claim == nil {
+		return fmt.Errorf("local claim is required")
+	}
+	if !strings.HasPrefix(claim.ClaimURI, LocalDialectURI+"/") || len(claim.ClaimURI) == len(LocalDialectURI)+1 {
+		return fmt.Errorf("claim URI '%s' is not in the local dialect '%s'", claim.ClaimURI, LocalDialectURI)
+	}
+	if claim.DisplayName == "" {
+		return fmt.Errorf("display name of claim '%s' is required", claim.ClaimURI)
+	}
+	if claim.DisplayOrder < 0 {
+		return fmt.Errorf("display order of claim '%s' must not be negative", claim.ClaimURI)
+	}
+	if len(claim.AttributeMappings) == 0 {
+		return fmt.Errorf("claim '%s' must be mapped to an attribute of at least one user store", claim.ClaimURI)
+	}
+	userstores := make(map[string]bool)
+	for _, mapping := range claim.AttributeMappings {
+		if mapping.Userstore == "" || mapping.MappedAttribute == "" {
+			return fmt.Errorf("attribute mappings of claim '%s' must have a user store and an attribute", claim.ClaimURI)
+		}
+		userstore := strings.ToUpper(mapping.Userstore)
+		if userstores[userstore] {
+			return fmt.Errorf("claim '%s' is mapped more than once in user store '%s'", claim.ClaimURI, mapping.Userstore)
+		}
+		userstores[userstore] = true
+	}
+	return nil
+}
+
+// FindMissingLocalClaims returns the given claim URIs that are not local claims of the organization.
+func (c *ClaimClient) FindMissingLocalClaims(ctx context.Context, claimURIs []string) ([]string, error) {
+	localClaims, err := c.ListLocalClaims(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	existingClaimURIs := make(map[string]struct{})
+	for _, localClaim := range *localClaims {
+		if localClaim.ClaimURI != nil {
+			existingClaimURIs[*localClaim.ClaimURI] = struct{}{}
+		}
+	}
+
+	var missingClaimURIs []string
+	for _, claimURI := range claimURIs {
+		if _, ok := existingClaimURIs[claimURI]; !ok {
+			missingClaimURIs = append(missingClaimURIs, claimURI)
+		}
+	}
+	return missingClaimURIs, nil
+}
+
+func (c *ClaimClient) updateLocalClaim(ctx context.Context, claimId string, request internal.LocalClaimReq) error {
+	resp, err := c.apiClient.UpdateLocalClaimWithResponse(ctx, claimId, request)
+	if err != nil {
+		return fmt.Errorf("failed to update local claim: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update local claim: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+func (c *ClaimClient) findLocalClaimByURI(ctx context.Context, claimURI string) (*LocalClaimResponseModel, error) {
+	claims, err := c.ListLocalClaims(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range *claims {
+		if stringValue(claim.ClaimURI) == claimURI {
+			return &claim, nil
+		}
+	}
+	return nil, nil
+}
+
+func buildLocalClaimRequest(claim *LocalClaimModel) internal.LocalClaimReq {
+	request := internal.LocalClaimReq{
+		ClaimURI:           claim.ClaimURI,
+		DisplayName:        claim.DisplayName,
+		AttributeMapping:   claim.AttributeMappings,
+		DisplayOrder:       &claim.DisplayOrder,
+		ReadOnly:           &claim.ReadOnly,
+		Required:           &claim.Required,
+		SupportedByDefault: &claim.SupportedByDefault,
+		MultiValued:        &claim.MultiValued,
+	}
+	if claim.Description != "" {
+		request.Description = &claim.Description
+	}
+	if claim.RegEx != "" {
+		request.RegEx = &claim.RegEx
+	}
+	if claim.Properties != nil {
+		properties := make([]internal.Property, 0, len(claim.Properties))
+		for _, key := range sortedKeys(claim.Properties) {
+			properties = append(properties, internal.Property{Key: key, Value: claim.Properties[key]})
+		}
+		request.Properties = &properties
+	}
+	return request
+}
+
+// diffLocalClaim lists the fields of an existing claim that differ from the desired claim
+func diffLocalClaim(existing *LocalClaimResponseModel, desired *LocalClaimModel) []common.FieldChange {
+	var changes []common.FieldChange
+	diff := func(field string, current interface{}, wanted interface{}) {
+		if !reflect.DeepEqual(current, wanted) {
+			changes = append(changes, common.FieldChange{Field: field, OldValue: current, NewValue: wanted})
+		}
+	}
+
+	diff("displayName", stringValue(existing.DisplayName), desired.DisplayName)
+	diff("description", stringValue(existing.Description), desired.Description)
+	var currentMappings []AttributeMappingModel
+	if existing.AttributeMapping != nil {
+		currentMappings = *existing.AttributeMapping
+	}
+	diff("attributeMapping", normalizeAttributeMappings(currentMappings), normalizeAttributeMappings(desired.AttributeMappings))
+	diff("displayOrder", intValue(existing.DisplayOrder), desired.DisplayOrder)
+	diff("readOnly", boolValue(existing.ReadOnly), desired.ReadOnly)
+	diff("required", boolValue(existing.Required), desired.Required)
+	diff("supportedByDefault", boolValue(existing.SupportedByDefault), desired.SupportedByDefault)
+	diff("multiValued", boolValue(existing.MultiValued), desired.MultiValued)
+	diff("regEx", stringValue(existing.RegEx), desired.RegEx)
+	if desired.Properties != nil {
+		current := map[string]string{}
+		if existing.Properties != nil {
+			for _, property := range *existing.Properties {
+				current[property.Key] = property.Value
+			}
+		}
+		diff("properties", current, desired.Properties)
+	}
+	return changes
+}
+
+// normalizeAttributeMappings sorts mappings by user store, which the server reports in upper case
+func normalizeAttributeMappings(mappings []AttributeMappingModel) []AttributeMappingModel {
+	normalized := make([]AttributeMappingModel, 0, len(mappings))
+	for _, mapping := range mappings {
+		normalized = append(normalized, AttributeMappingModel{
+			Userstore:       strings.ToUpper(mapping.Userstore),
+			MappedAttribute: mapping.MappedAttribute,
+		})
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Userstore < normalized[j].Userstore
+	})
+	return normalized
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func boolValue(value *bool) bool {
+	if value == nil {
+		return false
+	}
+	return *value
+}
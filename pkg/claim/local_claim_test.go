@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/common"
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const employeeIdClaim = `{
+	"id": "aHR0cDovL3dzbzIub3JnL2NsYWltcy9lbXBsb3llZUlk",
+	"claimURI": "http://wso2.org/claims/employeeId",
+	"dialectURI": "http://wso2.org/claims",
+	"displayName": "Employee ID",
+	"displayOrder": 10,
+	"readOnly": false,
+	"required": false,
+	"supportedByDefault": true,
+	"regEx": "^E[0-9]{6}$",
+	"attributeMapping": [{"mappedAttribute": "employeeId", "userstore": "PRIMARY"}],
+	"profiles": {"console": {"readOnly": true}},
+	"properties": [{"key": "isCustom", "value": "true"}]
+}`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *ClaimClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func employeeIdModel() *LocalClaimModel {
+	return &LocalClaimModel{
+		ClaimURI:           "http://wso2.org/claims/employeeId",
+		DisplayName:        "Employee ID",
+		AttributeMappings:  []AttributeMappingModel{{Userstore: "primary", MappedAttribute: "employeeId"}},
+		DisplayOrder:       10,
+		SupportedByDefault: true,
+		RegEx:              "^E[0-9]{6}$",
+	}
+}
+
+func TestEnsureLocalClaim(t *testing.T) {
+	t.Run("created", func(t *testing.T) {
+		var created map[string]interface{}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/server/v1/claim-dialects/local/claims":
+				_, _ = io.WriteString(w, `[]`)
+			case r.Method == http.MethodPost:
+				body, _ := io.ReadAll(r.Body)
+				require.NoError(t, json.Unmarshal(body, &created))
+				w.Header().Set("Location", "/api/server/v1/claim-dialects/local/claims/aHR0cDovL3dzbzIub3JnL2NsYWltcy9lbXBsb3llZUlk")
+				w.WriteHeader(http.StatusCreated)
+			default:
+				_, _ = io.WriteString(w, employeeIdClaim)
+			}
+		})
+
+		result, err := client.EnsureLocalClaim(context.Background(), employeeIdModel())
+		require.NoError(t, err)
+		assert.Equal(t, common.EnsureActionCreated, result.Action)
+		assert.Equal(t, "aHR0cDovL3dzbzIub3JnL2NsYWltcy9lbXBsb3llZUlk", *result.Claim.Id)
+		assert.Equal(t, "^E[0-9]{6}$", created["regEx"])
+		assert.Equal(t, float64(10), created["displayOrder"])
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, "["+employeeIdClaim+"]")
+		})
+
+		result, err := client.EnsureLocalClaim(context.Background(), employeeIdModel())
+		require.NoError(t, err)
+		assert.Equal(t, common.EnsureActionUnchanged, result.Action)
+		assert.Empty(t, result.Changes)
+	})
+
+	t.Run("updated", func(t *testing.T) {
+		var updated map[string]interface{}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodPut:
+				assert.Equal(t, "/api/server/v1/claim-dialects/local/claims/aHR0cDovL3dzbzIub3JnL2NsYWltcy9lbXBsb3llZUlk", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				require.NoError(t, json.Unmarshal(body, &updated))
+			case r.URL.Path == "/api/server/v1/claim-dialects/local/claims":
+				_, _ = io.WriteString(w, "["+employeeIdClaim+"]")
+			default:
+				_, _ = io.WriteString(w, employeeIdClaim)
+			}
+		})
+
+		desired := employeeIdModel()
+		desired.Required = true
+		desired.AttributeMappings = append(desired.AttributeMappings, AttributeMappingModel{Userstore: "LDAP", MappedAttribute: "employeeNumber"})
+		result, err := client.EnsureLocalClaim(context.Background(), desired)
+		require.NoError(t, err)
+		assert.Equal(t, common.EnsureActionUpdated, result.Action)
+
+		fields := make([]string, 0)
+		for _, change := range result.Changes {
+			fields = append(fields, change.Field)
+		}
+		assert.Equal(t, []string{"attributeMapping", "required"}, fields)
+		assert.Equal(t, true, updated["required"])
+		// Settings outside the model are carried over from the existing claim
+		assert.Equal(t, map[string]interface{}{"console": map[string]interface{}{"readOnly": true}}, updated["profiles"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"key": "isCustom", "value": "true"}}, updated["properties"])
+	})
+}
+
+func TestValidateLocalClaim(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*LocalClaimModel)
+		errMsg string
+	}{
+		{"valid", func(c *LocalClaimModel) {}, ""},
+		{"external dialect", func(c *LocalClaimModel) { c.ClaimURI = "http://wso2.org/oidc/claim/employee_id" }, "not in the local dialect"},
+		{"dialect only", func(c *LocalClaimModel) { c.ClaimURI = "http://wso2.org/claims/" }, "not in the local dialect"},
+		{"missing display name", func(c *LocalClaimModel) { c.DisplayName = "" }, "display name"},
+		{"no mappings", func(c *LocalClaimModel) { c.AttributeMappings = nil }, "at least one user store"},
+		{"duplicate user store", func(c *LocalClaimModel) {
+			c.AttributeMappings = append(c.AttributeMappings, AttributeMappingModel{Userstore: "PRIMARY", MappedAttribute: "empId"})
+		}, "more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claim := employeeIdModel()
+			tt.modify(claim)
+			err := ValidateLocalClaim(claim)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestFindMissingLocalClaims(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/server/v1/claim-dialects/local/claims", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, "["+employeeIdClaim+"]")
+	})
+
+	missing, err := client.FindMissingLocalClaims(context.Background(), []string{
+		"http://wso2.org/claims/employeeId",
+		"http://wso2.org/claims/costCenter",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://wso2.org/claims/costCenter"}, missing)
+}
@@ -20,6 +20,7 @@ package claim
 
 import (
 	"github.com/asgardeo/go/pkg/claim/internal"
+	"github.com/asgardeo/go/pkg/common"
 )
 
 type LocalClaimResponseModel = internal.LocalClaimRes
@@ -31,3 +32,38 @@ type ExcludeHiddenClaimsParamModel = internal.ExcludeHiddenClaimsQueryParam
 type ExternalClaimResponseModel = internal.ExternalClaimRes
 
 type ExternalClaimListParamsModel = internal.GetExternalClaimsParams
+
+// AttributeMappingModel maps a claim to an attribute of a user store
+type AttributeMappingModel = internal.AttributeMapping
+
+// LocalClaimModel defines a local claim, such as a custom user attribute.
+// It describes the full state of the claim, so unset fields are cleared on update.
+type LocalClaimModel struct {
+	// ClaimURI identifies the claim and must be in the local dialect, e.g. http://wso2.org/claims/employeeId
+	ClaimURI    string
+	DisplayName string
+	Description string
+	// AttributeMappings maps the claim to an attribute of each user store, e.g. PRIMARY to employeeId
+	AttributeMappings []AttributeMappingModel
+	// DisplayOrder orders the claim among other claims in profile and registration pages
+	DisplayOrder       int
+	ReadOnly           bool
+	Required           bool
+	SupportedByDefault bool
+	MultiValued        bool
+	// RegEx validates claim values entered by users
+	RegEx      string
+	Properties map[string]string
+}
+
+// LocalClaimEnsureResultModel defines the outcome of ensuring a local claim
+type LocalClaimEnsureResultModel struct {
+	Action  common.EnsureAction      `json:"action"`
+	Claim   *LocalClaimResponseModel `json:"claim"`
+	Changes []common.FieldChange     `json:"changes,omitempty"`
+}
+
+type ClaimDialectResponseModel = internal.ClaimDialectRes
+
+// ExternalClaimModel maps a claim of an external dialect to a local claim
+type ExternalClaimModel = internal.ExternalClaimReq
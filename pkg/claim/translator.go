@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClaimTranslator translates claim URIs between dialects using the external claim mappings of the
+// organization. Mappings are loaded once per dialect and cached in memory until they expire or are invalidated.
+type ClaimTranslator struct {
+	client *ClaimClient
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	dialects map[string]*dialectMappings
+}
+
+type dialectMappings struct {
+	// toLocal maps external claim URIs to local claim URIs
+	toLocal map[string]string
+	// fromLocal maps local claim URIs to the external claim URIs mapped to them
+	fromLocal map[string][]string
+	loadedAt  time.Time
+}
+
+// NewClaimTranslator creates a translator backed by the given client. Cached mappings expire after the given
+// TTL, or never when the TTL is zero.
+func NewClaimTranslator(client *ClaimClient, ttl time.Duration) *ClaimTranslator {
+	return &ClaimTranslator{
+		client:   client,
+		ttl:      ttl,
+		dialects: make(map[string]*dialectMappings),
+	}
+}
+
+// Translate returns the claim URI in the target dialect that corresponds to a claim URI in the source dialect.
+// Dialects are identified by URI, e.g. wellknown.DialectOIDC. It fails when the claim is not mapped or is
+// mapped to more than one claim in the target dialect; use TranslateAll to get every mapped claim.
+func (t *ClaimTranslator) Translate(ctx context.Context, claimURI string, fromDialectURI string, toDialectURI string) (string, error) {
+	claimURIs, err := t.TranslateAll(ctx, claimURI, fromDialectURI, toDialectURI)
+	if err != nil {
+		return "", err
+	}
+	if len(claimURIs) > 1 {
+		return "", fmt.Errorf("claim '%s' of dialect '%s' is mapped to more than one claim of dialect '%s': %s",
+			claimURI, fromDialectURI, toDialectURI, strings.Join(claimURIs, ", "))
+	}
+	return claimURIs[0], nil
+}
+
+// TranslateAll returns every claim URI in the target dialect that corresponds to a claim URI in the source
+// dialect, sorted by URI.
+func (t *ClaimTranslator) TranslateAll(ctx context.Context, claimURI string, fromDialectURI string, toDialectURI string) ([]string, error) {
+	localClaimURI := claimURI
+	if fromDialectURI != LocalDialectURI {
+		mappings, err := t.mappings(ctx, fromDialectURI)
+		if err != nil {
+			return nil, err
+		}
+		mapped, ok := mappings.toLocal[claimURI]
+		if !ok {
+			return nil, fmt.Errorf("claim '%s' is not mapped in dialect '%s'", claimURI, fromDialectURI)
+		}
+		localClaimURI = mapped
+	}
+	if toDialectURI == LocalDialectURI {
+		return []string{localClaimURI}, nil
+	}
+
+	mappings, err := t.mappings(ctx, toDialectURI)
+	if err != nil {
+		return nil, err
+	}
+	claimURIs := mappings.fromLocal[localClaimURI]
+	if len(claimURIs) == 0 {
+		return nil, fmt.Errorf("local claim '%s' is not mapped in dialect '%s'", localClaimURI, toDialectURI)
+	}
+	return append([]string{}, claimURIs...), nil
+}
+
+// Invalidate drops the cached mappings of the given dialects, or of all dialects when none are given.
+func (t *ClaimTranslator) Invalidate(dialectURIs ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(dialectURIs) == 0 {
+		t.dialects = make(map[string]*dialectMappings)
+		return
+	}
+	for _, dialectURI := range dialectURIs {
+		delete(t.dialects, dialectURI)
+	}
+}
+
+func (t *ClaimTranslator) mappings(ctx context.Context, dialectURI string) (*dialectMappings, error) {
+	t.mu.Lock()
+	cached, ok := t.dialects[dialectURI]
+	t.mu.Unlock()
+	if ok && (t.ttl == 0 || time.Since(cached.loadedAt) < t.ttl) {
+		return cached, nil
+	}
+
+	// Mappings are loaded without holding the lock; concurrent loads of the same dialect are harmless
+	claims, err := t.client.ListExternalClaims(ctx, DialectIDFromURI(dialectURI), nil)
+	if err != nil {
+		return nil, err
+	}
+	mappings := &dialectMappings{
+		toLocal:   make(map[string]string),
+		fromLocal: make(map[string][]string),
+		loadedAt:  time.Now(),
+	}
+	for _, claim := range *claims {
+		externalURI := stringValue(claim.ClaimURI)
+		localURI := stringValue(claim.MappedLocalClaimURI)
+		if externalURI == "" || localURI == "" {
+			continue
+		}
+		mappings.toLocal[externalURI] = localURI
+		mappings.fromLocal[localURI] = append(mappings.fromLocal[localURI], externalURI)
+	}
+	for _, externalURIs := range mappings.fromLocal {
+		sort.Strings(externalURIs)
+	}
+
+	t.mu.Lock()
+	t.dialects[dialectURI] = mappings
+	t.mu.Unlock()
+	return mappings, nil
+}
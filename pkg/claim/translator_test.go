@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claim
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/asgardeo/go/pkg/claim/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimTranslator(t *testing.T) {
+	requests := map[string]int{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/server/v1/claim-dialects/" + wellknown.DialectIDOIDC + "/claims":
+			_, _ = io.WriteString(w, `[
+				{"claimURI":"email","mappedLocalClaimURI":"http://wso2.org/claims/emailaddress"},
+				{"claimURI":"given_name","mappedLocalClaimURI":"http://wso2.org/claims/givenname"},
+				{"claimURI":"preferred_username","mappedLocalClaimURI":"http://wso2.org/claims/username"},
+				{"claimURI":"username","mappedLocalClaimURI":"http://wso2.org/claims/username"}
+			]`)
+		case "/api/server/v1/claim-dialects/" + wellknown.DialectIDSCIM2User + "/claims":
+			_, _ = io.WriteString(w, `[
+				{"claimURI":"urn:ietf:params:scim:schemas:core:2.0:User:emails","mappedLocalClaimURI":"http://wso2.org/claims/emailaddress"}
+			]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	translator := NewClaimTranslator(client, time.Hour)
+	ctx := context.Background()
+
+	scimClaim, err := translator.Translate(ctx, "email", wellknown.DialectOIDC, wellknown.DialectSCIM2User)
+	require.NoError(t, err)
+	assert.Equal(t, "urn:ietf:params:scim:schemas:core:2.0:User:emails", scimClaim)
+
+	localClaim, err := translator.Translate(ctx, "given_name", wellknown.DialectOIDC, wellknown.DialectLocal)
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.ClaimGivenName, localClaim)
+
+	oidcClaims, err := translator.TranslateAll(ctx, wellknown.ClaimUsername, wellknown.DialectLocal, wellknown.DialectOIDC)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"preferred_username", "username"}, oidcClaims)
+	_, err = translator.Translate(ctx, wellknown.ClaimUsername, wellknown.DialectLocal, wellknown.DialectOIDC)
+	assert.ErrorContains(t, err, "more than one claim")
+
+	_, err = translator.Translate(ctx, "given_name", wellknown.DialectOIDC, wellknown.DialectSCIM2User)
+	assert.ErrorContains(t, err, "not mapped in dialect")
+
+	// Each dialect is loaded once until invalidated
+	assert.Equal(t, 1, requests["/api/server/v1/claim-dialects/"+wellknown.DialectIDOIDC+"/claims"])
+	assert.Equal(t, 1, requests["/api/server/v1/claim-dialects/"+wellknown.DialectIDSCIM2User+"/claims"])
+	translator.Invalidate(wellknown.DialectOIDC)
+	_, err = translator.Translate(ctx, "email", wellknown.DialectOIDC, wellknown.DialectLocal)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["/api/server/v1/claim-dialects/"+wellknown.DialectIDOIDC+"/claims"])
+}
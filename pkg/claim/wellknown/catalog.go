@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package wellknown catalogs the standard claim dialects and common local claims, so that dialect IDs and
+// claim URIs do not need to be hard-coded.
+package wellknown
+
+//go:generate go run gen_catalog.go -in catalog.yaml -out catalog_gen.go
+
+// DialectModel describes a standard claim dialect
+type DialectModel struct {
+	Name        string
+	URI         string
+	Description string
+	// ID is the dialect ID used by the claim management API
+	ID string
+}
+
+// LocalClaimModel describes a common claim of the local dialect
+type LocalClaimModel struct {
+	Name        string
+	URI         string
+	DisplayName string
+}
+
+// DialectByURI returns the standard dialect with the given URI
+func DialectByURI(uri string) (DialectModel, bool) {
+	for _, dialect := range Dialects {
+		if dialect.URI == uri {
+			return dialect, true
+		}
+	}
+	return DialectModel{}, false
+}
+
+// LocalClaimByURI returns the common local claim with the given URI
+func LocalClaimByURI(uri string) (LocalClaimModel, bool) {
+	for _, claim := range LocalClaims {
+		if claim.URI == uri {
+			return claim, true
+		}
+	}
+	return LocalClaimModel{}, false
+}
@@ -0,0 +1,111 @@
+// Code generated by gen_catalog.go from catalog.yaml. DO NOT EDIT.
+
+package wellknown
+
+// Claim dialect URIs
+const (
+	DialectLocal              = "http://wso2.org/claims"
+	DialectOIDC               = "http://wso2.org/oidc/claim"
+	DialectSCIM2Core          = "urn:ietf:params:scim:schemas:core:2.0"
+	DialectSCIM2User          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	DialectSCIM2Enterprise    = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	DialectEIDASNaturalPerson = "http://eidas.europa.eu/attributes/naturalperson"
+	DialectEIDASLegalPerson   = "http://eidas.europa.eu/attributes/legalperson"
+	DialectAX                 = "http://axschema.org"
+)
+
+// Claim dialect IDs, which are the dialect URIs encoded as base64url without padding
+const (
+	DialectIDLocal              = "local"
+	DialectIDOIDC               = "aHR0cDovL3dzbzIub3JnL29pZGMvY2xhaW0"
+	DialectIDSCIM2Core          = "dXJuOmlldGY6cGFyYW1zOnNjaW06c2NoZW1hczpjb3JlOjIuMA"
+	DialectIDSCIM2User          = "dXJuOmlldGY6cGFyYW1zOnNjaW06c2NoZW1hczpjb3JlOjIuMDpVc2Vy"
+	DialectIDSCIM2Enterprise    = "dXJuOmlldGY6cGFyYW1zOnNjaW06c2NoZW1hczpleHRlbnNpb246ZW50ZXJwcmlzZToyLjA6VXNlcg"
+	DialectIDEIDASNaturalPerson = "aHR0cDovL2VpZGFzLmV1cm9wYS5ldS9hdHRyaWJ1dGVzL25hdHVyYWxwZXJzb24"
+	DialectIDEIDASLegalPerson   = "aHR0cDovL2VpZGFzLmV1cm9wYS5ldS9hdHRyaWJ1dGVzL2xlZ2FscGVyc29u"
+	DialectIDAX                 = "aHR0cDovL2F4c2NoZW1hLm9yZw"
+)
+
+// Local claim URIs
+const (
+	ClaimUsername        = "http://wso2.org/claims/username"
+	ClaimUserID          = "http://wso2.org/claims/userid"
+	ClaimEmailAddress    = "http://wso2.org/claims/emailaddress"
+	ClaimGivenName       = "http://wso2.org/claims/givenname"
+	ClaimMiddleName      = "http://wso2.org/claims/middleName"
+	ClaimLastName        = "http://wso2.org/claims/lastname"
+	ClaimFullName        = "http://wso2.org/claims/fullname"
+	ClaimNickname        = "http://wso2.org/claims/nickname"
+	ClaimDisplayName     = "http://wso2.org/claims/displayName"
+	ClaimMobile          = "http://wso2.org/claims/mobile"
+	ClaimTelephone       = "http://wso2.org/claims/telephone"
+	ClaimDateOfBirth     = "http://wso2.org/claims/dob"
+	ClaimGender          = "http://wso2.org/claims/gender"
+	ClaimLocale          = "http://wso2.org/claims/local"
+	ClaimTimeZone        = "http://wso2.org/claims/timeZone"
+	ClaimCountry         = "http://wso2.org/claims/country"
+	ClaimRegion          = "http://wso2.org/claims/region"
+	ClaimLocality        = "http://wso2.org/claims/locality"
+	ClaimPostalCode      = "http://wso2.org/claims/postalcode"
+	ClaimStreetAddress   = "http://wso2.org/claims/streetaddress"
+	ClaimOrganization    = "http://wso2.org/claims/organization"
+	ClaimTitle           = "http://wso2.org/claims/title"
+	ClaimPhoto           = "http://wso2.org/claims/photourl"
+	ClaimProfileURL      = "http://wso2.org/claims/url"
+	ClaimGroups          = "http://wso2.org/claims/groups"
+	ClaimRoles           = "http://wso2.org/claims/roles"
+	ClaimCreated         = "http://wso2.org/claims/created"
+	ClaimModified        = "http://wso2.org/claims/modified"
+	ClaimEmailVerified   = "http://wso2.org/claims/identity/emailVerified"
+	ClaimPhoneVerified   = "http://wso2.org/claims/identity/phoneVerified"
+	ClaimAccountLocked   = "http://wso2.org/claims/identity/accountLocked"
+	ClaimAccountDisabled = "http://wso2.org/claims/identity/accountDisabled"
+)
+
+// Dialects lists the standard claim dialects
+var Dialects = []DialectModel{
+	{Name: "Local", URI: DialectLocal, ID: DialectIDLocal, Description: "Local claims of the organization"},
+	{Name: "OIDC", URI: DialectOIDC, ID: DialectIDOIDC, Description: "OpenID Connect standard claims"},
+	{Name: "SCIM2Core", URI: DialectSCIM2Core, ID: DialectIDSCIM2Core, Description: "SCIM 2.0 core schema"},
+	{Name: "SCIM2User", URI: DialectSCIM2User, ID: DialectIDSCIM2User, Description: "SCIM 2.0 user schema"},
+	{Name: "SCIM2Enterprise", URI: DialectSCIM2Enterprise, ID: DialectIDSCIM2Enterprise, Description: "SCIM 2.0 enterprise user extension"},
+	{Name: "EIDASNaturalPerson", URI: DialectEIDASNaturalPerson, ID: DialectIDEIDASNaturalPerson, Description: "eIDAS natural person attributes"},
+	{Name: "EIDASLegalPerson", URI: DialectEIDASLegalPerson, ID: DialectIDEIDASLegalPerson, Description: "eIDAS legal person attributes"},
+	{Name: "AX", URI: DialectAX, ID: DialectIDAX, Description: "OpenID attribute exchange schema"},
+}
+
+// LocalClaims lists the common local claims
+var LocalClaims = []LocalClaimModel{
+	{Name: "Username", URI: ClaimUsername, DisplayName: "Username"},
+	{Name: "UserID", URI: ClaimUserID, DisplayName: "User ID"},
+	{Name: "EmailAddress", URI: ClaimEmailAddress, DisplayName: "Email"},
+	{Name: "GivenName", URI: ClaimGivenName, DisplayName: "First Name"},
+	{Name: "MiddleName", URI: ClaimMiddleName, DisplayName: "Middle Name"},
+	{Name: "LastName", URI: ClaimLastName, DisplayName: "Last Name"},
+	{Name: "FullName", URI: ClaimFullName, DisplayName: "Full Name"},
+	{Name: "Nickname", URI: ClaimNickname, DisplayName: "Nick Name"},
+	{Name: "DisplayName", URI: ClaimDisplayName, DisplayName: "Display Name"},
+	{Name: "Mobile", URI: ClaimMobile, DisplayName: "Mobile"},
+	{Name: "Telephone", URI: ClaimTelephone, DisplayName: "Telephone"},
+	{Name: "DateOfBirth", URI: ClaimDateOfBirth, DisplayName: "Birth Date"},
+	{Name: "Gender", URI: ClaimGender, DisplayName: "Gender"},
+	{Name: "Locale", URI: ClaimLocale, DisplayName: "Locale"},
+	{Name: "TimeZone", URI: ClaimTimeZone, DisplayName: "Time Zone"},
+	{Name: "Country", URI: ClaimCountry, DisplayName: "Country"},
+	{Name: "Region", URI: ClaimRegion, DisplayName: "Region"},
+	{Name: "Locality", URI: ClaimLocality, DisplayName: "Locality"},
+	{Name: "PostalCode", URI: ClaimPostalCode, DisplayName: "Postal Code"},
+	{Name: "StreetAddress", URI: ClaimStreetAddress, DisplayName: "Street Address"},
+	{Name: "Organization", URI: ClaimOrganization, DisplayName: "Organization"},
+	{Name: "Title", URI: ClaimTitle, DisplayName: "Title"},
+	{Name: "Photo", URI: ClaimPhoto, DisplayName: "Photo URL"},
+	{Name: "ProfileURL", URI: ClaimProfileURL, DisplayName: "URL"},
+	{Name: "Groups", URI: ClaimGroups, DisplayName: "Groups"},
+	{Name: "Roles", URI: ClaimRoles, DisplayName: "Roles"},
+	{Name: "Created", URI: ClaimCreated, DisplayName: "Created Time"},
+	{Name: "Modified", URI: ClaimModified, DisplayName: "Last Modified Time"},
+	{Name: "EmailVerified", URI: ClaimEmailVerified, DisplayName: "Email Verified"},
+	{Name: "PhoneVerified", URI: ClaimPhoneVerified, DisplayName: "Phone Verified"},
+	{Name: "AccountLocked", URI: ClaimAccountLocked, DisplayName: "Account Locked"},
+	{Name: "AccountDisabled", URI: ClaimAccountDisabled, DisplayName: "Account Disabled"},
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wellknown_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/claim"
+	"github.com/asgardeo/go/pkg/claim/wellknown"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	for _, dialect := range wellknown.Dialects {
+		assert.Equal(t, claim.DialectIDFromURI(dialect.URI), dialect.ID, dialect.Name)
+	}
+
+	for _, localClaim := range wellknown.LocalClaims {
+		assert.True(t, strings.HasPrefix(localClaim.URI, wellknown.DialectLocal+"/"), localClaim.Name)
+	}
+
+	dialect, ok := wellknown.DialectByURI("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User")
+	assert.True(t, ok)
+	assert.Equal(t, "SCIM2Enterprise", dialect.Name)
+	localClaim, ok := wellknown.LocalClaimByURI("http://wso2.org/claims/emailaddress")
+	assert.True(t, ok)
+	assert.Equal(t, "Email", localClaim.DisplayName)
+	_, ok = wellknown.DialectByURI("urn:example:unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,149 @@
+//go:build ignore
+
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// gen_catalog generates catalog_gen.go from catalog.yaml.
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	localDialectURI = "http://wso2.org/claims"
+	localDialectID  = "local"
+)
+
+type catalog struct {
+	Dialects []struct {
+		Name        string `yaml:"name"`
+		URI         string `yaml:"uri"`
+		Description string `yaml:"description"`
+		ID          string `yaml:"-"`
+	} `yaml:"dialects"`
+	Claims []struct {
+		Name        string `yaml:"name"`
+		URI         string `yaml:"uri"`
+		DisplayName string `yaml:"displayName"`
+	} `yaml:"claims"`
+}
+
+var catalogTemplate = template.Must(template.New("catalog").Parse(`// Code generated by gen_catalog.go from catalog.yaml. DO NOT EDIT.
+
+package wellknown
+
+// Claim dialect URIs
+const (
+{{- range .Dialects}}
+	Dialect{{.Name}} = "{{.URI}}"
+{{- end}}
+)
+
+// Claim dialect IDs, which are the dialect URIs encoded as base64url without padding
+const (
+{{- range .Dialects}}
+	DialectID{{.Name}} = "{{.ID}}"
+{{- end}}
+)
+
+// Local claim URIs
+const (
+{{- range .Claims}}
+	Claim{{.Name}} = "{{.URI}}"
+{{- end}}
+)
+
+// Dialects lists the standard claim dialects
+var Dialects = []DialectModel{
+{{- range .Dialects}}
+	{Name: "{{.Name}}", URI: Dialect{{.Name}}, ID: DialectID{{.Name}}, Description: "{{.Description}}"},
+{{- end}}
+}
+
+// LocalClaims lists the common local claims
+var LocalClaims = []LocalClaimModel{
+{{- range .Claims}}
+	{Name: "{{.Name}}", URI: Claim{{.Name}}, DisplayName: "{{.DisplayName}}"},
+{{- end}}
+}
+`))
+
+func main() {
+	in := flag.String("in", "catalog.yaml", "catalog definition")
+	out := flag.String("out", "catalog_gen.go", "generated Go file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("failed to read catalog: %v", err)
+	}
+	var c catalog
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		log.Fatalf("failed to parse catalog: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for i := range c.Dialects {
+		dialect := &c.Dialects[i]
+		if err := checkName(names, "Dialect"+dialect.Name); err != nil {
+			log.Fatal(err)
+		}
+		if dialect.URI == localDialectURI {
+			dialect.ID = localDialectID
+		} else {
+			dialect.ID = base64.RawURLEncoding.EncodeToString([]byte(dialect.URI))
+		}
+	}
+	for i := range c.Claims {
+		claim := &c.Claims[i]
+		if err := checkName(names, "Claim"+claim.Name); err != nil {
+			log.Fatal(err)
+		}
+		claim.URI = localDialectURI + "/" + claim.URI
+	}
+
+	var buf bytes.Buffer
+	if err := catalogTemplate.Execute(&buf, c); err != nil {
+		log.Fatalf("failed to render catalog: %v", err)
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format catalog: %v", err)
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatalf("failed to write catalog: %v", err)
+	}
+}
+
+func checkName(names map[string]bool, name string) error {
+	if name == "" || names[name] {
+		return fmt.Errorf("duplicate or empty catalog entry '%s'", name)
+	}
+	names[name] = true
+	return nil
+}
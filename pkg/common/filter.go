@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FilterOperator is a comparison operator of a filter expression
+type FilterOperator string
+
+// Filter operators supported by the list endpoints
+const (
+	FilterOperatorEq FilterOperator = "eq"
+	FilterOperatorCo FilterOperator = "co"
+	FilterOperatorSw FilterOperator = "sw"
+	FilterOperatorEw FilterOperator = "ew"
+)
+
+// FilterLogicalOperator combines filter expressions
+type FilterLogicalOperator string
+
+// Logical operators supported by the list endpoints
+const (
+	FilterLogicalAnd FilterLogicalOperator = "and"
+	FilterLogicalOr  FilterLogicalOperator = "or"
+)
+
+var filterAttributePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.:$-]*$`)
+
+// FilterExpression is a SCIM style filter expression. It is either a comparison of an attribute
+// with a value or a logical combination of other expressions.
+type FilterExpression struct {
+	Attribute string
+	Operator  FilterOperator
+	Value     string
+
+	Logical  FilterLogicalOperator
+	Operands []FilterExpression
+}
+
+// Eq builds an expression matching attributes equal to the value
+func Eq(attribute string, value string) FilterExpression {
+	return FilterExpression{Attribute: attribute, Operator: FilterOperatorEq, Value: value}
+}
+
+// Co builds an expression matching attributes containing the value
+func Co(attribute string, value string) FilterExpression {
+	return FilterExpression{Attribute: attribute, Operator: FilterOperatorCo, Value: value}
+}
+
+// Sw builds an expression matching attributes starting with the value
+func Sw(attribute string, value string) FilterExpression {
+	return FilterExpression{Attribute: attribute, Operator: FilterOperatorSw, Value: value}
+}
+
+// Ew builds an expression matching attributes ending with the value
+func Ew(attribute string, value string) FilterExpression {
+	return FilterExpression{Attribute: attribute, Operator: FilterOperatorEw, Value: value}
+}
+
+// And builds an expression matching when all the given expressions match
+func And(expressions ...FilterExpression) FilterExpression {
+	return FilterExpression{Logical: FilterLogicalAnd, Operands: expressions}
+}
+
+// Or builds an expression matching when any of the given expressions match
+func Or(expressions ...FilterExpression) FilterExpression {
+	return FilterExpression{Logical: FilterLogicalOr, Operands: expressions}
+}
+
+// IsLogical reports whether the expression combines other expressions
+func (e FilterExpression) IsLogical() bool {
+	return e.Logical != ""
+}
+
+// String renders the expression as a filter string with quoted and escaped values
+func (e FilterExpression) String() string {
+	if !e.IsLogical() {
+		return fmt.Sprintf("%s %s %s", e.Attribute, e.Operator, QuoteFilterValue(e.Value))
+	}
+
+	parts := make([]string, 0, len(e.Operands))
+	for _, operand := range e.Operands {
+		part := operand.String()
+		if operand.IsLogical() && operand.Logical != e.Logical && len(operand.Operands) > 1 {
+			part = "(" + part + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " "+string(e.Logical)+" ")
+}
+
+// Validate checks that the expression has valid attributes, operators and operands
+func (e FilterExpression) Validate() error {
+	if e.IsLogical() {
+		if e.Logical != FilterLogicalAnd && e.Logical != FilterLogicalOr {
+			return fmt.Errorf("unsupported logical operator '%s' in filter", e.Logical)
+		}
+		if len(e.Operands) == 0 {
+			return fmt.Errorf("logical operator '%s' in filter has no operands", e.Logical)
+		}
+		for _, operand := range e.Operands {
+			if err := operand.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !filterAttributePattern.MatchString(e.Attribute) {
+		return fmt.Errorf("invalid attribute '%s' in filter", e.Attribute)
+	}
+	switch e.Operator {
+	case FilterOperatorEq, FilterOperatorCo, FilterOperatorSw, FilterOperatorEw:
+		return nil
+	default:
+		return fmt.Errorf("unsupported operator '%s' in filter", e.Operator)
+	}
+}
+
+// QuoteFilterValue quotes a filter value, escaping backslashes and double quotes
+func QuoteFilterValue(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
+
+// ParseFilter parses and validates a filter string such as `name sw "google" and (tag eq 2FA or tag eq MFA)`.
+// Values may be quoted or, as accepted by the server, unquoted single words.
+func ParseFilter(filter string) (FilterExpression, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return FilterExpression{}, err
+	}
+	if len(tokens) == 0 {
+		return FilterExpression{}, fmt.Errorf("filter is empty")
+	}
+
+	parser := &filterParser{tokens: tokens}
+	expression, err := parser.parseOr()
+	if err != nil {
+		return FilterExpression{}, err
+	}
+	if parser.pos < len(parser.tokens) {
+		return FilterExpression{}, fmt.Errorf("unexpected '%s' in filter", parser.tokens[parser.pos].text)
+	}
+	if err := expression.Validate(); err != nil {
+		return FilterExpression{}, err
+	}
+	return expression, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenWord filterTokenKind = iota
+	filterTokenString
+	filterTokenOpenParen
+	filterTokenCloseParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterTokenOpenParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterTokenCloseParen, text: ")"})
+			i++
+		case r == '"':
+			var value strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					value.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted value in filter")
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString, text: value.String()})
+		default:
+			start := i
+			for i < len(runes) && !strings.ContainsRune(" \t\n()\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, text: string(runes[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == filterTokenWord &&
+		strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+// parseOr parses expressions joined by 'or', which binds weaker than 'and'
+func (p *filterParser) parseOr() (FilterExpression, error) {
+	return p.parseLogical(FilterLogicalOr, p.parseAnd)
+}
+
+func (p *filterParser) parseAnd() (FilterExpression, error) {
+	return p.parseLogical(FilterLogicalAnd, p.parseTerm)
+}
+
+func (p *filterParser) parseLogical(logical FilterLogicalOperator, parseOperand func() (FilterExpression, error)) (FilterExpression, error) {
+	first, err := parseOperand()
+	if err != nil {
+		return FilterExpression{}, err
+	}
+	operands := []FilterExpression{first}
+	for p.peekKeyword(string(logical)) {
+		p.pos++
+		operand, err := parseOperand()
+		if err != nil {
+			return FilterExpression{}, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return FilterExpression{Logical: logical, Operands: operands}, nil
+}
+
+func (p *filterParser) parseTerm() (FilterExpression, error) {
+	if p.pos >= len(p.tokens) {
+		return FilterExpression{}, fmt.Errorf("unexpected end of filter")
+	}
+
+	if p.tokens[p.pos].kind == filterTokenOpenParen {
+		p.pos++
+		expression, err := p.parseOr()
+		if err != nil {
+			return FilterExpression{}, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != filterTokenCloseParen {
+			return FilterExpression{}, fmt.Errorf("missing closing parenthesis in filter")
+		}
+		p.pos++
+		return expression, nil
+	}
+
+	if p.pos+3 > len(p.tokens) {
+		return FilterExpression{}, fmt.Errorf("incomplete expression in filter")
+	}
+	attribute, operator, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if attribute.kind != filterTokenWord {
+		return FilterExpression{}, fmt.Errorf("expected attribute in filter but found '%s'", attribute.text)
+	}
+	if operator.kind != filterTokenWord {
+		return FilterExpression{}, fmt.Errorf("expected operator after '%s' in filter", attribute.text)
+	}
+	if value.kind != filterTokenWord && value.kind != filterTokenString {
+		return FilterExpression{}, fmt.Errorf("expected value after '%s %s' in filter", attribute.text, operator.text)
+	}
+	p.pos += 3
+
+	return FilterExpression{
+		Attribute: attribute.text,
+		Operator:  FilterOperator(strings.ToLower(operator.text)),
+		Value:     value.text,
+	}, nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterExpressionString(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression FilterExpression
+		expected   string
+	}{
+		{
+			name:       "simple equality",
+			expression: Eq("name", "My App"),
+			expected:   `name eq "My App"`,
+		},
+		{
+			name:       "escapes quotes and backslashes",
+			expression: Eq("name", `say "hi" \ bye`),
+			expected:   `name eq "say \"hi\" \\ bye"`,
+		},
+		{
+			name:       "and of comparisons",
+			expression: And(Sw("name", "google"), Eq("isEnabled", "true")),
+			expected:   `name sw "google" and isEnabled eq "true"`,
+		},
+		{
+			name:       "nested or is parenthesized",
+			expression: And(Co("name", "fi"), Or(Eq("tag", "2FA"), Ew("tag", "MFA"))),
+			expected:   `name co "fi" and (tag eq "2FA" or tag ew "MFA")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.expression.String())
+		})
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	expression, err := ParseFilter(`name sw fi and (tag eq "2FA" or tag EQ "M\"FA")`)
+	require.NoError(t, err)
+	assert.Equal(t, And(Sw("name", "fi"), Or(Eq("tag", "2FA"), Eq("tag", `M"FA`))), expression)
+
+	// Round trip of a built expression
+	built := Or(And(Eq("name", "a b"), Co("description", `x\y`)), Eq("type", "tenant"))
+	parsed, err := ParseFilter(built.String())
+	require.NoError(t, err)
+	assert.Equal(t, built, parsed)
+
+	invalidFilters := map[string]string{
+		"":              "filter is empty",
+		"name":          "incomplete expression",
+		`name eq "open`: "unterminated quoted value",
+		"name gt 5":     "unsupported operator 'gt'",
+		"(name eq a":    "missing closing parenthesis",
+		"name eq a b":   "unexpected 'b'",
+		`"name" eq a`:   "expected attribute",
+		"name eq a and": "unexpected end of filter",
+		"na%me eq a":    "invalid attribute 'na%me'",
+	}
+	for filter, expectedErr := range invalidFilters {
+		_, err := ParseFilter(filter)
+		assert.ErrorContains(t, err, expectedErr, "filter: %q", filter)
+	}
+}
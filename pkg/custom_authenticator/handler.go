@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package custom_authenticator
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/authenticator"
+)
+
+// maxRequestBodySize limits the size of authentication requests accepted by the handler
+const maxRequestBodySize = 1 << 20
+
+// AuthenticateFunc authenticates the user of a login flow. Returning an error responds with an ERROR status;
+// the error itself is logged and never sent to Asgardeo.
+type AuthenticateFunc func(ctx context.Context, request *AuthenticationRequestModel) (Result, error)
+
+// Handler serves the external service endpoint of a user-defined authenticator
+type Handler struct {
+	auth         authenticator.EndpointAuthModel
+	authenticate AuthenticateFunc
+	logger       *slog.Logger
+}
+
+// NewHandler creates a handler that accepts requests carrying the given credentials, which must match the
+// endpoint authentication configured on the authenticator, and passes them to the callback.
+func NewHandler(auth authenticator.EndpointAuthModel, authenticate AuthenticateFunc) (*Handler, error) {
+	if err := auth.Validate(); err != nil {
+		return nil, err
+	}
+	if authenticate == nil {
+		return nil, fmt.Errorf("authenticate callback is required")
+	}
+	return &Handler{auth: auth, authenticate: authenticate, logger: slog.Default()}, nil
+}
+
+// WithLogger sets the logger used to report callback errors and rejected requests
+func (h *Handler) WithLogger(logger *slog.Logger) *Handler {
+	h.logger = logger
+	return h
+}
+
+// ServeHTTP decodes the authentication request, verifies its credentials, runs the callback and encodes the result.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed", "only POST requests are accepted")
+		return
+	}
+	if !h.authorized(r) {
+		h.logger.Warn("rejected authentication request with invalid credentials", slog.String("remoteAddr", r.RemoteAddr))
+		writeError(w, http.StatusUnauthorized, "unauthorized", "invalid or missing credentials")
+		return
+	}
+
+	var request AuthenticationRequestModel
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request", "request body is not a valid authentication request")
+		return
+	}
+	if request.ActionType != ActionTypeAuthentication {
+		writeError(w, http.StatusBadRequest, "invalid request", fmt.Sprintf("unsupported action type '%s'", request.ActionType))
+		return
+	}
+	if request.FlowId == "" {
+		writeError(w, http.StatusBadRequest, "invalid request", "flow ID is required")
+		return
+	}
+
+	result, err := h.authenticate(r.Context(), &request)
+	if err != nil {
+		h.logger.Error("authentication callback failed", slog.String("flowId", request.FlowId), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "authentication failed", "the external service could not process the request")
+		return
+	}
+	if err := result.validate(&request); err != nil {
+		h.logger.Error("authentication callback returned an invalid result", slog.String("flowId", request.FlowId), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "authentication failed", "the external service could not process the request")
+		return
+	}
+	writeJSON(w, http.StatusOK, result.response)
+}
+
+func (h *Handler) authorized(r *http.Request) bool {
+	switch h.auth.Type {
+	case authenticator.EndpointAuthNone:
+		return true
+	case authenticator.EndpointAuthBasic:
+		username, password, ok := r.BasicAuth()
+		// Compare both values so the response time does not reveal which one was wrong
+		usernameMatch := secretEqual(username, h.auth.Username)
+		passwordMatch := secretEqual(password, h.auth.Password)
+		return ok && usernameMatch && passwordMatch
+	case authenticator.EndpointAuthBearer:
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		return ok && secretEqual(token, h.auth.AccessToken)
+	case authenticator.EndpointAuthAPIKey:
+		values := r.Header.Values(h.auth.Header)
+		return len(values) == 1 && secretEqual(values[0], h.auth.Value)
+	}
+	return false
+}
+
+func secretEqual(actual string, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(actual), []byte(expected)) == 1
+}
+
+func writeError(w http.ResponseWriter, status int, message string, description string) {
+	writeJSON(w, status, AuthenticationResponseModel{
+		ActionStatus:     ActionStatusError,
+		ErrorMessage:     message,
+		ErrorDescription: description,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, response AuthenticationResponseModel) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package custom_authenticator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/authenticator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func serve(t *testing.T, handler http.Handler, body []byte, setAuth func(*http.Request)) (int, AuthenticationResponseModel) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/authenticate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if setAuth != nil {
+		setAuth(req)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var response AuthenticationResponseModel
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return rec.Code, response
+}
+
+func newTestHandler(t *testing.T, auth authenticator.EndpointAuthModel, authenticate AuthenticateFunc) *Handler {
+	t.Helper()
+	handler, err := NewHandler(auth, authenticate)
+	require.NoError(t, err)
+	return handler.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestHandlerResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		result   func(*AuthenticationRequestModel) Result
+		expected string
+	}{
+		{
+			name:    "redirect on identification",
+			fixture: "identification_request.json",
+			result: func(r *AuthenticationRequestModel) Result {
+				return Redirect("https://auth.example.com/login?flowId=" + r.FlowId)
+			},
+			expected: `{"actionStatus":"INCOMPLETE","operations":[{"op":"redirect","url":"https://auth.example.com/login?flowId=6f1b4c3a-5d2e-4f7a-9b8c-1a2b3c4d5e6f"}]}`,
+		},
+		{
+			name:    "success with claims",
+			fixture: "verification_request.json",
+			result: func(r *AuthenticationRequestModel) Result {
+				return Success(UserModel{
+					Id:        r.Event.User.Id,
+					Claims:    []ClaimModel{{URI: "http://wso2.org/claims/emailaddress", Value: "alex@example.com"}},
+					UserStore: r.Event.UserStore,
+				})
+			},
+			expected: `{"actionStatus":"SUCCESS","data":{"user":{"id":"8f3e2d1c-0b9a-4f8e-7d6c-5b4a39281706",` +
+				`"claims":[{"uri":"http://wso2.org/claims/emailaddress","value":"alex@example.com"}],` +
+				`"userStore":{"id":"REVGQVVMVA==","name":"DEFAULT"}}}}`,
+		},
+		{
+			name:    "failure",
+			fixture: "verification_request.json",
+			result: func(r *AuthenticationRequestModel) Result {
+				return Failure("invalidOTP", "The one-time password is incorrect")
+			},
+			expected: `{"actionStatus":"FAILED","failureReason":"invalidOTP","failureDescription":"The one-time password is incorrect"}`,
+		},
+		{
+			name:    "invalid result",
+			fixture: "verification_request.json",
+			result: func(r *AuthenticationRequestModel) Result {
+				return Success(UserModel{})
+			},
+			expected: `{"actionStatus":"ERROR","errorMessage":"authentication failed","errorDescription":"the external service could not process the request"}`,
+		},
+		{
+			name:    "operation not allowed",
+			fixture: "verification_request.json",
+			result: func(r *AuthenticationRequestModel) Result {
+				return Incomplete(OperationModel{Op: "prompt"})
+			},
+			expected: `{"actionStatus":"ERROR","errorMessage":"authentication failed","errorDescription":"the external service could not process the request"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestHandler(t, authenticator.NoAuth(), func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+				return tt.result(r), nil
+			})
+			req := httptest.NewRequest(http.MethodPost, "/authenticate", bytes.NewReader(loadFixture(t, tt.fixture)))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
+
+func TestHandlerDecodesRequest(t *testing.T) {
+	var received *AuthenticationRequestModel
+	handler := newTestHandler(t, authenticator.NoAuth(), func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+		received = r
+		return Failure("invalidOTP", ""), nil
+	})
+	status, _ := serve(t, handler, loadFixture(t, "verification_request.json"), nil)
+	require.Equal(t, http.StatusOK, status)
+
+	require.NotNil(t, received)
+	assert.Equal(t, "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a", received.FlowId)
+	assert.Equal(t, 2, received.Event.CurrentStepIndex)
+	assert.Equal(t, "myorg", received.Event.Tenant.Name)
+	assert.Equal(t, "Pickup Dashboard", received.Event.Application.Name)
+	require.NotNil(t, received.Event.User)
+	assert.Equal(t, "8f3e2d1c-0b9a-4f8e-7d6c-5b4a39281706", received.Event.User.Id)
+	assert.Equal(t, []interface{}{"staff", "drivers"}, received.Event.User.Claims[1].Value)
+	assert.Equal(t, []string{"482913"}, received.Event.Request.AdditionalParams[0].Value)
+	assert.Equal(t, []AuthenticatedStepModel{{Index: 1, Name: "BasicAuthenticator", Idp: "LOCAL"}}, received.Event.AuthenticatedSteps)
+}
+
+func TestHandlerCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		auth    authenticator.EndpointAuthModel
+		setAuth func(*http.Request)
+		allowed bool
+	}{
+		{
+			name:    "basic",
+			auth:    authenticator.BasicAuth("asgardeo", "s3cret"),
+			setAuth: func(r *http.Request) { r.SetBasicAuth("asgardeo", "s3cret") },
+			allowed: true,
+		},
+		{
+			name:    "basic with wrong password",
+			auth:    authenticator.BasicAuth("asgardeo", "s3cret"),
+			setAuth: func(r *http.Request) { r.SetBasicAuth("asgardeo", "guess") },
+		},
+		{
+			name: "basic missing",
+			auth: authenticator.BasicAuth("asgardeo", "s3cret"),
+		},
+		{
+			name:    "bearer",
+			auth:    authenticator.BearerAuth("t0ken"),
+			setAuth: func(r *http.Request) { r.Header.Set("Authorization", "Bearer t0ken") },
+			allowed: true,
+		},
+		{
+			name:    "bearer with wrong scheme",
+			auth:    authenticator.BearerAuth("t0ken"),
+			setAuth: func(r *http.Request) { r.Header.Set("Authorization", "Basic t0ken") },
+		},
+		{
+			name:    "api key",
+			auth:    authenticator.APIKeyAuth("X-API-Key", "k3y"),
+			setAuth: func(r *http.Request) { r.Header.Set("X-API-Key", "k3y") },
+			allowed: true,
+		},
+		{
+			name:    "api key in wrong header",
+			auth:    authenticator.APIKeyAuth("X-API-Key", "k3y"),
+			setAuth: func(r *http.Request) { r.Header.Set("X-Other-Key", "k3y") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := newTestHandler(t, tt.auth, func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+				called = true
+				return Redirect("https://auth.example.com/login"), nil
+			})
+			status, response := serve(t, handler, loadFixture(t, "identification_request.json"), tt.setAuth)
+
+			assert.Equal(t, tt.allowed, called)
+			if tt.allowed {
+				assert.Equal(t, http.StatusOK, status)
+				assert.Equal(t, ActionStatusIncomplete, response.ActionStatus)
+			} else {
+				assert.Equal(t, http.StatusUnauthorized, status)
+				assert.Equal(t, ActionStatusError, response.ActionStatus)
+			}
+		})
+	}
+}
+
+func TestHandlerRejectsInvalidRequests(t *testing.T) {
+	handler := newTestHandler(t, authenticator.NoAuth(), func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+		t.Fatal("callback must not be called")
+		return Result{}, nil
+	})
+
+	status, response := serve(t, handler, loadFixture(t, "invalid_action_request.json"), nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, ActionStatusError, response.ActionStatus)
+	assert.Contains(t, response.ErrorDescription, "PRE_ISSUE_ACCESS_TOKEN")
+
+	status, response = serve(t, handler, []byte(`{"actionType":`), nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, ActionStatusError, response.ActionStatus)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/authenticate", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+}
+
+func TestHandlerHidesCallbackErrors(t *testing.T) {
+	handler := newTestHandler(t, authenticator.NoAuth(), func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+		return Result{}, fmt.Errorf("database password rejected: hunter2")
+	})
+	status, response := serve(t, handler, loadFixture(t, "identification_request.json"), nil)
+
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Equal(t, ActionStatusError, response.ActionStatus)
+	assert.NotContains(t, response.ErrorDescription, "hunter2")
+}
+
+func TestNewHandlerValidatesArguments(t *testing.T) {
+	_, err := NewHandler(authenticator.BasicAuth("", ""), func(ctx context.Context, r *AuthenticationRequestModel) (Result, error) {
+		return Result{}, nil
+	})
+	assert.Error(t, err)
+
+	_, err = NewHandler(authenticator.NoAuth(), nil)
+	assert.Error(t, err)
+}
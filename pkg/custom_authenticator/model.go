@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package custom_authenticator
+
+// ActionTypeAuthentication is the action type of requests sent to the external service of a user-defined authenticator
+const ActionTypeAuthentication = "AUTHENTICATION"
+
+// ActionStatus is the outcome reported to Asgardeo
+type ActionStatus string
+
+// Action statuses
+const (
+	ActionStatusSuccess    ActionStatus = "SUCCESS"
+	ActionStatusFailed     ActionStatus = "FAILED"
+	ActionStatusIncomplete ActionStatus = "INCOMPLETE"
+	ActionStatusError      ActionStatus = "ERROR"
+)
+
+// OperationRedirect is the operation that redirects the user to the external service
+const OperationRedirect = "redirect"
+
+// AuthenticationRequestModel is the request Asgardeo sends to the external service during login
+type AuthenticationRequestModel struct {
+	ActionType        string           `json:"actionType"`
+	FlowId            string           `json:"flowId"`
+	RequestId         string           `json:"requestId,omitempty"`
+	Event             EventModel       `json:"event"`
+	AllowedOperations []OperationModel `json:"allowedOperations,omitempty"`
+}
+
+// EventModel describes the login in progress
+type EventModel struct {
+	Request      RequestContextModel `json:"request"`
+	Tenant       TenantModel         `json:"tenant"`
+	Organization *OrganizationModel  `json:"organization,omitempty"`
+	Application  ApplicationModel    `json:"application"`
+	// User is set when a previous step identified the user, which is the case for VERIFICATION authenticators
+	User               *UserModel               `json:"user,omitempty"`
+	UserStore          *UserStoreModel          `json:"userStore,omitempty"`
+	CurrentStepIndex   int                      `json:"currentStepIndex"`
+	AuthenticatedSteps []AuthenticatedStepModel `json:"authenticatedSteps,omitempty"`
+}
+
+// RequestContextModel holds the headers and parameters of the login request that were shared with the service
+type RequestContextModel struct {
+	AdditionalHeaders []HeaderModel    `json:"additionalHeaders,omitempty"`
+	AdditionalParams  []ParameterModel `json:"additionalParams,omitempty"`
+}
+
+type HeaderModel struct {
+	Name  string   `json:"name"`
+	Value []string `json:"value"`
+}
+
+type ParameterModel struct {
+	Name  string   `json:"name"`
+	Value []string `json:"value"`
+}
+
+type TenantModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OrganizationModel struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	OrgHandle string `json:"orgHandle,omitempty"`
+	Depth     int    `json:"depth,omitempty"`
+}
+
+type ApplicationModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserModel is a user identified by a previous step or by the external service
+type UserModel struct {
+	Id        string          `json:"id"`
+	Claims    []ClaimModel    `json:"claims,omitempty"`
+	Groups    []string        `json:"groups,omitempty"`
+	UserStore *UserStoreModel `json:"userStore,omitempty"`
+}
+
+// ClaimModel is a user attribute. The value is a string or, for multi-valued claims, a list of strings.
+type ClaimModel struct {
+	URI   string      `json:"uri"`
+	Value interface{} `json:"value"`
+}
+
+type UserStoreModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type AuthenticatedStepModel struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+	Idp   string `json:"idp"`
+}
+
+// OperationModel is an operation Asgardeo allows or the service asks Asgardeo to perform
+type OperationModel struct {
+	Op  string `json:"op"`
+	URL string `json:"url,omitempty"`
+}
+
+// AuthenticationResponseModel is the response returned to Asgardeo
+type AuthenticationResponseModel struct {
+	ActionStatus       ActionStatus             `json:"actionStatus"`
+	Data               *AuthenticationDataModel `json:"data,omitempty"`
+	Operations         []OperationModel         `json:"operations,omitempty"`
+	FailureReason      string                   `json:"failureReason,omitempty"`
+	FailureDescription string                   `json:"failureDescription,omitempty"`
+	ErrorMessage       string                   `json:"errorMessage,omitempty"`
+	ErrorDescription   string                   `json:"errorDescription,omitempty"`
+}
+
+// AuthenticationDataModel carries the authenticated user of a successful response
+type AuthenticationDataModel struct {
+	User UserModel `json:"user"`
+}
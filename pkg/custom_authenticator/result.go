@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package custom_authenticator
+
+import "fmt"
+
+// Result is the outcome of an authentication callback
+type Result struct {
+	response AuthenticationResponseModel
+}
+
+// Success reports that the user was authenticated. The user ID is required, while the claims, groups and user
+// store are optional.
+func Success(user UserModel) Result {
+	return Result{response: AuthenticationResponseModel{
+		ActionStatus: ActionStatusSuccess,
+		Data:         &AuthenticationDataModel{User: user},
+	}}
+}
+
+// Failure reports that the user could not be authenticated. The reason and description are shown to the user.
+func Failure(reason string, description string) Result {
+	return Result{response: AuthenticationResponseModel{
+		ActionStatus:       ActionStatusFailed,
+		FailureReason:      reason,
+		FailureDescription: description,
+	}}
+}
+
+// Redirect asks Asgardeo to send the user to the given URL. The service is called again with the same flow ID
+// once the user returns.
+func Redirect(url string) Result {
+	return Incomplete(OperationModel{Op: OperationRedirect, URL: url})
+}
+
+// Incomplete reports that authentication needs further interaction, described by the given operations
+func Incomplete(operations ...OperationModel) Result {
+	return Result{response: AuthenticationResponseModel{
+		ActionStatus: ActionStatusIncomplete,
+		Operations:   operations,
+	}}
+}
+
+// Status returns the action status of the result
+func (r Result) Status() ActionStatus {
+	return r.response.ActionStatus
+}
+
+// Response returns the response sent to Asgardeo for the result
+func (r Result) Response() AuthenticationResponseModel {
+	return r.response
+}
+
+func (r Result) validate(request *AuthenticationRequestModel) error {
+	switch r.response.ActionStatus {
+	case ActionStatusSuccess:
+		if r.response.Data == nil || r.response.Data.User.Id == "" {
+			return fmt.Errorf("successful result must include the user ID")
+		}
+	case ActionStatusFailed:
+		if r.response.FailureReason == "" {
+			return fmt.Errorf("failed result must include a failure reason")
+		}
+	case ActionStatusIncomplete:
+		if len(r.response.Operations) == 0 {
+			return fmt.Errorf("incomplete result must include at least one operation")
+		}
+		for _, operation := range r.response.Operations {
+			if !request.allows(operation.Op) {
+				return fmt.Errorf("operation '%s' is not allowed for this request", operation.Op)
+			}
+			if operation.Op == OperationRedirect && operation.URL == "" {
+				return fmt.Errorf("redirect operation must include a URL")
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported result status '%s'", r.response.ActionStatus)
+	}
+	return nil
+}
+
+func (r *AuthenticationRequestModel) allows(op string) bool {
+	for _, allowed := range r.AllowedOperations {
+		if allowed.Op == op {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+// GetFederatedAssociationConfig retrieves how federated users of an identity provider are linked to local accounts.
+func (c *IdentityProviderClient) GetFederatedAssociationConfig(ctx context.Context, idpId string) (*FederatedAssociationConfigModel, error) {
+	resp, err := c.apiClient.GetFederatedAssociationConfigWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated association config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get federated association config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return convertToFederatedAssociationConfig(resp.JSON200), nil
+}
+
+// UpdateFederatedAssociationConfig updates how federated users of an identity provider are linked to local accounts
+// after checking that the lookup attributes are local claims.
+func (c *IdentityProviderClient) UpdateFederatedAssociationConfig(ctx context.Context, idpId string, associationConfig *FederatedAssociationConfigModel) (*FederatedAssociationConfigModel, error) {
+	if associationConfig == nil {
+		return nil, fmt.Errorf("federated association config is required")
+	}
+	if associationConfig.IsEnabled && len(associationConfig.LookupAttributes) == 0 {
+		return nil, fmt.Errorf("at least one lookup attribute is required to enable federated association")
+	}
+	if len(associationConfig.LookupAttributes) > 0 {
+		missingClaimURIs, err := c.findMissingLocalClaims(ctx, associationConfig.LookupAttributes)
+		if err != nil {
+			return nil, err
+		}
+		if len(missingClaimURIs) > 0 {
+			return nil, fmt.Errorf("lookup attributes are not local claims: %s", strings.Join(missingClaimURIs, ", "))
+		}
+	}
+
+	lookupAttributes := append([]string{}, associationConfig.LookupAttributes...)
+	resp, err := c.apiClient.UpdateFederatedAssociationConfigWithResponse(ctx, idpId, internal.AssociationRequest{
+		IsEnabled:       &associationConfig.IsEnabled,
+		LookupAttribute: &lookupAttributes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update federated association config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update federated association config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return convertToFederatedAssociationConfig(resp.JSON200), nil
+}
+
+func convertToFederatedAssociationConfig(association *internal.AssociationResponse) *FederatedAssociationConfigModel {
+	associationConfig := &FederatedAssociationConfigModel{LookupAttributes: []string{}}
+	if association == nil {
+		return associationConfig
+	}
+	associationConfig.IsEnabled = association.IsEnabled != nil && *association.IsEnabled
+	if association.LookupAttribute != nil {
+		associationConfig.LookupAttributes = append(associationConfig.LookupAttributes, *association.LookupAttribute...)
+	}
+	return associationConfig
+}
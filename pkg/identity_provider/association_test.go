@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFederatedAssociationConfig(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/idp-1/implicit-association": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"isEnabled": true, "lookupAttribute": ["http://wso2.org/claims/emailaddress"]}`)
+		},
+		"GET /api/server/v1/identity-providers/idp-2/implicit-association": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{}`)
+		},
+	})
+
+	associationConfig, err := client.GetFederatedAssociationConfig(context.Background(), "idp-1")
+	require.NoError(t, err)
+	assert.Equal(t, &FederatedAssociationConfigModel{
+		IsEnabled:        true,
+		LookupAttributes: []string{"http://wso2.org/claims/emailaddress"},
+	}, associationConfig)
+
+	// A missing configuration is reported as disabled with no lookup attributes
+	associationConfig, err = client.GetFederatedAssociationConfig(context.Background(), "idp-2")
+	require.NoError(t, err)
+	assert.Equal(t, &FederatedAssociationConfigModel{LookupAttributes: []string{}}, associationConfig)
+}
+
+func TestUpdateFederatedAssociationConfig(t *testing.T) {
+	var updated internal.AssociationRequest
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/claim-dialects/local/claims": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `[{"claimURI": "http://wso2.org/claims/emailaddress"}]`)
+		},
+		"PUT /api/server/v1/identity-providers/idp-1/implicit-association": func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			writeJSON(w, http.StatusOK, updated)
+		},
+	})
+
+	associationConfig, err := client.UpdateFederatedAssociationConfig(context.Background(), "idp-1", &FederatedAssociationConfigModel{
+		IsEnabled:        true,
+		LookupAttributes: []string{"http://wso2.org/claims/emailaddress"},
+	})
+	require.NoError(t, err)
+	assert.True(t, *updated.IsEnabled)
+	assert.Equal(t, []string{"http://wso2.org/claims/emailaddress"}, *updated.LookupAttribute)
+	assert.Equal(t, []string{"http://wso2.org/claims/emailaddress"}, associationConfig.LookupAttributes)
+}
+
+func TestUpdateFederatedAssociationConfigValidatesLookupAttributes(t *testing.T) {
+	client := newMappingTestClient(t)
+
+	_, err := client.UpdateFederatedAssociationConfig(context.Background(), "idp-1", &FederatedAssociationConfigModel{
+		IsEnabled:        true,
+		LookupAttributes: []string{"http://wso2.org/claims/emailaddress", "email"},
+	})
+	assert.EqualError(t, err, "lookup attributes are not local claims: email")
+}
+
+func TestUpdateFederatedAssociationConfigRequiresLookupAttributesWhenEnabled(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{})
+
+	_, err := client.UpdateFederatedAssociationConfig(context.Background(), "idp-1", &FederatedAssociationConfigModel{IsEnabled: true})
+	assert.EqualError(t, err, "at least one lookup attribute is required to enable federated association")
+}
@@ -22,12 +22,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/asgardeo/go/pkg/common"
 	"github.com/asgardeo/go/pkg/config"
 	"github.com/asgardeo/go/pkg/identity_provider/internal"
 )
 
+const connectedAppsPageSize int32 = 100
+
 type IdentityProviderClient struct {
 	config    *config.ClientConfig
 	apiClient *internal.ClientWithResponses
@@ -68,3 +71,126 @@ func (c *IdentityProviderClient) List(ctx context.Context, idpGetParams *Identit
 
 	return resp.JSON200, nil
 }
+
+// GetByName finds an identity provider by its exact name.
+func (c *IdentityProviderClient) GetByName(ctx context.Context, name string) (*IdentityProviderListItemModel, error) {
+	filter := common.Eq("name", name).String()
+	resp, err := c.apiClient.GetIDPsWithResponse(ctx, &internal.GetIDPsParams{
+		Filter: &filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	if resp.JSON200 != nil && resp.JSON200.IdentityProviders != nil {
+		for _, idp := range *resp.JSON200.IdentityProviders {
+			if idp.Name != nil && *idp.Name == name {
+				return &idp, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no identity provider found with name: %s", name)
+}
+
+// Get retrieves an identity provider by its ID.
+func (c *IdentityProviderClient) Get(ctx context.Context, id string) (*IdentityProviderResponseModel, error) {
+	resp, err := c.apiClient.GetIDPWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// Create creates a new identity provider.
+func (c *IdentityProviderClient) Create(ctx context.Context, idp *IdentityProviderCreateModel) (*IdentityProviderResponseModel, error) {
+	resp, err := c.apiClient.AddIDPWithResponse(ctx, *idp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON201, nil
+}
+
+// Patch applies patch operations to the basic information of an identity provider.
+func (c *IdentityProviderClient) Patch(ctx context.Context, id string, operations []IdentityProviderPatchModel) (*IdentityProviderResponseModel, error) {
+	resp, err := c.apiClient.PatchIDPWithResponse(ctx, id, operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to patch identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// Delete deletes an identity provider. Unless forced, deletion is refused while applications
+// still use the identity provider.
+func (c *IdentityProviderClient) Delete(ctx context.Context, id string, opts *IdentityProviderDeleteOptionsModel) error {
+	force := opts != nil && opts.Force
+	if !force {
+		connectedApps, err := c.GetConnectedApps(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(connectedApps) > 0 {
+			appIds := make([]string, 0, len(connectedApps))
+			for _, app := range connectedApps {
+				if app.AppId != nil {
+					appIds = append(appIds, *app.AppId)
+				}
+			}
+			return fmt.Errorf("identity provider '%s' is used by %d application(s): %s; use the force option to delete it anyway",
+				id, len(connectedApps), strings.Join(appIds, ", "))
+		}
+	}
+
+	params := internal.DeleteIDPParams{}
+	if force {
+		params.Force = &force
+	}
+	resp, err := c.apiClient.DeleteIDPWithResponse(ctx, id, &params)
+	if err != nil {
+		return fmt.Errorf("failed to delete identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// GetConnectedApps retrieves all applications that use an identity provider in their login flow.
+func (c *IdentityProviderClient) GetConnectedApps(ctx context.Context, id string) ([]ConnectedAppModel, error) {
+	connectedApps := []ConnectedAppModel{}
+	limit := connectedAppsPageSize
+	offset := int32(0)
+	for {
+		resp, err := c.apiClient.GetConnectedAppsWithResponse(ctx, id, &internal.GetConnectedAppsParams{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get connected applications: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, fmt.Errorf("failed to get connected applications: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+		}
+		if resp.JSON200 == nil || resp.JSON200.ConnectedApps == nil || len(*resp.JSON200.ConnectedApps) == 0 {
+			break
+		}
+
+		connectedApps = append(connectedApps, *resp.JSON200.ConnectedApps...)
+		offset += int32(len(*resp.JSON200.ConnectedApps))
+		if resp.JSON200.TotalResults == nil || int(offset) >= *resp.JSON200.TotalResults {
+			break
+		}
+	}
+	return connectedApps, nil
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRoutedTestClient returns a client for a server that serves the given "METHOD path" routes
+func newRoutedTestClient(t *testing.T, routes map[string]http.HandlerFunc) *IdentityProviderClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return newTestClient(t, server)
+}
+
+func mergeRoutes(routes ...map[string]http.HandlerFunc) map[string]http.HandlerFunc {
+	merged := map[string]http.HandlerFunc{}
+	for _, r := range routes {
+		for key, handler := range r {
+			merged[key] = handler
+		}
+	}
+	return merged
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if text, ok := body.(string); ok {
+		_, _ = w.Write([]byte(text))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestGet(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/idp-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"id": "idp-id", "name": "Google"}`)
+		},
+	})
+
+	idp, err := client.Get(context.Background(), "idp-id")
+	require.NoError(t, err)
+	assert.Equal(t, "Google", *idp.Name)
+}
+
+func TestCreate(t *testing.T) {
+	var created IdentityProviderCreateModel
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"POST /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			writeJSON(w, http.StatusCreated, `{"id": "idp-id", "name": "Corporate IdP"}`)
+		},
+	})
+
+	idp, err := client.Create(context.Background(), &IdentityProviderCreateModel{Name: "Corporate IdP"})
+	require.NoError(t, err)
+	assert.Equal(t, "idp-id", *idp.Id)
+	assert.Equal(t, "Corporate IdP", created.Name)
+}
+
+func TestCreateReportsConflict(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"POST /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusConflict, `{"code": "IDP-60001"}`)
+		},
+	})
+
+	_, err := client.Create(context.Background(), &IdentityProviderCreateModel{Name: "Corporate IdP"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `status 409, body: {"code": "IDP-60001"}`)
+}
+
+func TestPatch(t *testing.T) {
+	var operations []IdentityProviderPatchModel
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"PATCH /api/server/v1/identity-providers/idp-id": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&operations))
+			writeJSON(w, http.StatusOK, `{"id": "idp-id", "name": "Google", "description": "Consumer login"}`)
+		},
+	})
+
+	description := "Consumer login"
+	idp, err := client.Patch(context.Background(), "idp-id", []IdentityProviderPatchModel{
+		{Operation: internal.REPLACE, Path: "/description", Value: &description},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Consumer login", *idp.Description)
+	require.Len(t, operations, 1)
+	assert.Equal(t, "/description", operations[0].Path)
+}
+
+func TestGetByName(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, `name eq "Google"`, r.URL.Query().Get("filter"))
+			writeJSON(w, http.StatusOK, `{"identityProviders": [{"id": "other-id", "name": "Google Workspace"}, {"id": "idp-id", "name": "Google"}]}`)
+		},
+	})
+
+	idp, err := client.GetByName(context.Background(), "Google")
+	require.NoError(t, err)
+	assert.Equal(t, "idp-id", *idp.Id)
+}
+
+func TestGetByNameNotFound(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusNotFound, `{"code": "IDP-60002"}`)
+		},
+	})
+
+	idp, err := client.GetByName(context.Background(), "Google")
+	require.Error(t, err)
+	assert.Nil(t, idp)
+	assert.Contains(t, err.Error(), `failed to get identity provider: status 404, body: {"code": "IDP-60002"}`)
+}
+
+func TestDeleteRefusedWhileConnectedApps(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/idp-id/connected-apps": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"totalResults": 2, "connectedApps": [{"appId": "app-1"}, {"appId": "app-2"}]}`)
+		},
+	})
+
+	err := client.Delete(context.Background(), "idp-id", nil)
+	require.Error(t, err)
+	assert.Equal(t, "identity provider 'idp-id' is used by 2 application(s): app-1, app-2; use the force option to delete it anyway", err.Error())
+}
+
+func TestDeleteWithoutConnectedApps(t *testing.T) {
+	deleted := false
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/idp-id/connected-apps": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"totalResults": 0, "connectedApps": []}`)
+		},
+		"DELETE /api/server/v1/identity-providers/idp-id": func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.URL.Query().Get("force"))
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+
+	require.NoError(t, client.Delete(context.Background(), "idp-id", nil))
+	assert.True(t, deleted)
+}
+
+func TestDeleteForced(t *testing.T) {
+	deleted := false
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"DELETE /api/server/v1/identity-providers/idp-id": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("force"))
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+
+	require.NoError(t, client.Delete(context.Background(), "idp-id", &IdentityProviderDeleteOptionsModel{Force: true}))
+	assert.True(t, deleted)
+}
+
+func TestGetConnectedAppsPages(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/idp-id/connected-apps": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("offset") == "0" {
+				writeJSON(w, http.StatusOK, `{"totalResults": 3, "connectedApps": [{"appId": "app-1"}, {"appId": "app-2"}]}`)
+				return
+			}
+			assert.Equal(t, "2", r.URL.Query().Get("offset"))
+			writeJSON(w, http.StatusOK, `{"totalResults": 3, "connectedApps": [{"appId": "app-3"}]}`)
+		},
+	})
+
+	apps, err := client.GetConnectedApps(context.Background(), "idp-id")
+	require.NoError(t, err)
+	require.Len(t, apps, 3)
+	assert.Equal(t, "app-3", *apps[2].AppId)
+}
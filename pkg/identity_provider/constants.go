@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+// FederatedAuthenticatorIDs are the IDs of the federated authenticators available in Asgardeo.
+// Each ID is the base64url encoded name of the authenticator.
+var FederatedAuthenticatorIDs = struct {
+	Apple      string
+	Duo        string
+	EmailOTP   string
+	Facebook   string
+	GitHub     string
+	GoogleOIDC string
+	Hypr       string
+	Iproov     string
+	IWAKrb     string
+	Microsoft  string
+	MSLive     string
+	Office365  string
+	OIDC       string
+	OrgEnt     string
+	PwdReset   string
+	SAML       string
+	SIWE       string
+	SMSOTP     string
+	Twitter    string
+	Yahoo      string
+}{
+	Apple:      "QXBwbGVPSURDQXV0aGVudGljYXRvcg",
+	Duo:        "RHVvQXV0aGVudGljYXRvcg",
+	EmailOTP:   "RW1haWxPVFA",
+	Facebook:   "RmFjZWJvb2tBdXRoZW50aWNhdG9y",
+	GitHub:     "R2l0aHViQXV0aGVudGljYXRvcg",
+	GoogleOIDC: "R29vZ2xlT0lEQ0F1dGhlbnRpY2F0b3I",
+	Hypr:       "SFlQUkF1dGhlbnRpY2F0b3I",
+	Iproov:     "SXByb292QXV0aGVudGljYXRvcg",
+	IWAKrb:     "SVdBS2VyYmVyb3NBdXRoZW50aWNhdG9y",
+	Microsoft:  "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
+	MSLive:     "TWljcm9zb2Z0V2luZG93c0xpdmVBdXRoZW50aWNhdG9y",
+	Office365:  "T2ZmaWNlMzY1QXV0aGVudGljYXRvcg",
+	OIDC:       "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
+	OrgEnt:     "T3JnYW5pemF0aW9uQXV0aGVudGljYXRvcg",
+	PwdReset:   "cGFzc3dvcmQtcmVzZXQtZW5mb3JjZXI",
+	SAML:       "U0FNTFNTT0F1dGhlbnRpY2F0b3I",
+	SIWE:       "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
+	SMSOTP:     "U01TT1RQ",
+	Twitter:    "VHdpdHRlckF1dGhlbnRpY2F0b3I",
+	Yahoo:      "WWFob29PQXV0aDJBdXRoZW50aWNhdG9y",
+}
+
+// OutboundConnectorIDs are the IDs of the outbound provisioning connectors available in Asgardeo.
+// Each ID is the base64url encoded name of the connector.
+var OutboundConnectorIDs = struct {
+	GoogleWorkspace string
+	Salesforce      string
+	SCIM            string
+	SCIM2           string
+}{
+	GoogleWorkspace: "Z29vZ2xlYXBwcw",
+	Salesforce:      "c2FsZXNmb3JjZQ",
+	SCIM:            "c2NpbQ",
+	SCIM2:           "c2NpbTI",
+}
@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+const (
+	oidcDiscoveryPath       = "/.well-known/openid-configuration"
+	samlHTTPPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlHTTPRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	maxMetadataSize         = 5 << 20
+)
+
+var defaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// CreateFromOIDCDiscovery creates an OpenID Connect connection using the endpoints published in the
+// provider's .well-known/openid-configuration document.
+func (c *IdentityProviderClient) CreateFromOIDCDiscovery(ctx context.Context, name string, issuerURL string, clientID string, secret string,
+	opts *EnterpriseConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	if name == "" || clientID == "" || secret == "" {
+		return nil, fmt.Errorf("name, client ID and secret are required to create a connection")
+	}
+	if opts == nil {
+		opts = &EnterpriseConnectionOptionsModel{}
+	}
+
+	discovery, err := c.FetchOIDCDiscovery(ctx, issuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := defaultOIDCScopes
+	if len(opts.Scopes) > 0 {
+		scopes = opts.Scopes
+	}
+	propertyValues := map[string]string{
+		"ClientId":         clientID,
+		"ClientSecret":     secret,
+		"OAuth2AuthzEPUrl": discovery.AuthorizationEndpoint,
+		"OAuth2TokenEPUrl": discovery.TokenEndpoint,
+		"callbackUrl":      c.callbackURL(opts.CallbackURL),
+		"Scopes":           strings.Join(scopes, " "),
+	}
+	if discovery.UserinfoEndpoint != "" {
+		propertyValues["UserInfoUrl"] = discovery.UserinfoEndpoint
+	}
+	if discovery.EndSessionEndpoint != "" {
+		propertyValues["OIDCLogoutEPUrl"] = discovery.EndSessionEndpoint
+	}
+
+	idp := buildConnection(name, opts.Description, FederatedAuthenticatorIDs.OIDC, propertyValues)
+	idp.IdpIssuerName = &discovery.Issuer
+	idp.Certificate = &internal.Certificate{
+		JwksUri: &discovery.JwksURI,
+	}
+	return c.Create(ctx, &idp)
+}
+
+// FetchOIDCDiscovery retrieves and validates the discovery document of an OpenID Provider.
+func (c *IdentityProviderClient) FetchOIDCDiscovery(ctx context.Context, issuerURL string) (*OIDCDiscoveryDocumentModel, error) {
+	if err := requireHTTPS(issuerURL, "issuer URL"); err != nil {
+		return nil, err
+	}
+	issuer := strings.TrimSuffix(issuerURL, "/")
+
+	body, err := c.fetchDocument(ctx, issuer+oidcDiscoveryPath, "OIDC discovery document")
+	if err != nil {
+		return nil, err
+	}
+
+	var discovery OIDCDiscoveryDocumentModel
+	if err := json.Unmarshal(body, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC discovery document: %w", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("issuer '%s' in the discovery document does not match '%s'", discovery.Issuer, issuerURL)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("OIDC discovery document of '%s' is missing the authorization, token or JWKS endpoint", issuerURL)
+	}
+	return &discovery, nil
+}
+
+// CreateFromSAMLMetadata creates a SAML connection from the identity provider's metadata, given either as
+// the metadata XML or as an https URL serving it.
+func (c *IdentityProviderClient) CreateFromSAMLMetadata(ctx context.Context, name string, metadata string, opts *EnterpriseConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is required to create a connection")
+	}
+	if opts == nil {
+		opts = &EnterpriseConnectionOptionsModel{}
+	}
+
+	metadataXML := []byte(metadata)
+	if !strings.HasPrefix(strings.TrimSpace(metadata), "<") {
+		if err := requireHTTPS(metadata, "metadata URL"); err != nil {
+			return nil, err
+		}
+		body, err := c.fetchDocument(ctx, metadata, "SAML metadata")
+		if err != nil {
+			return nil, err
+		}
+		metadataXML = body
+	}
+
+	samlMetadata, err := ParseSAMLMetadata(metadataXML)
+	if err != nil {
+		return nil, err
+	}
+
+	spEntityID := opts.SPEntityID
+	if spEntityID == "" {
+		spEntityID = strings.TrimSuffix(c.config.BaseURL, "/")
+	}
+	requestMethod := "post"
+	if samlMetadata.SSOBinding == samlHTTPRedirectBinding {
+		requestMethod = "redirect"
+	}
+	propertyValues := map[string]string{
+		"IdPEntityId":   samlMetadata.EntityID,
+		"SPEntityId":    spEntityID,
+		"SSOUrl":        samlMetadata.SSOURL,
+		"RequestMethod": requestMethod,
+		"callbackUrl":   c.callbackURL(opts.CallbackURL),
+	}
+	if samlMetadata.LogoutURL != "" {
+		propertyValues["IsLogoutEnabled"] = "true"
+		propertyValues["LogoutReqUrl"] = samlMetadata.LogoutURL
+	}
+
+	idp := buildConnection(name, opts.Description, FederatedAuthenticatorIDs.SAML, propertyValues)
+	idp.IdpIssuerName = &samlMetadata.EntityID
+	if len(samlMetadata.SigningCertificates) > 0 {
+		certificates := make([]string, 0, len(samlMetadata.SigningCertificates))
+		for _, cert := range samlMetadata.SigningCertificates {
+			der, _ := base64.StdEncoding.DecodeString(cert)
+			pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+			certificates = append(certificates, base64.StdEncoding.EncodeToString(pemCert))
+		}
+		idp.Certificate = &internal.Certificate{
+			Certificates: &certificates,
+		}
+	}
+	return c.Create(ctx, &idp)
+}
+
+type samlEntityDescriptor struct {
+	EntityID         string                `xml:"entityID,attr"`
+	IDPSSODescriptor *samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors      []samlKeyDescriptor `xml:"KeyDescriptor"`
+	SingleSignOnService []samlEndpoint      `xml:"SingleSignOnService"`
+	SingleLogoutService []samlEndpoint      `xml:"SingleLogoutService"`
+}
+
+type samlKeyDescriptor struct {
+	Use              string   `xml:"use,attr"`
+	X509Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseSAMLMetadata extracts the entity ID, SSO and logout URLs and signing certificates from
+// SAML identity provider metadata. For an EntitiesDescriptor the first identity provider is used.
+func ParseSAMLMetadata(metadata []byte) (*SAMLMetadataModel, error) {
+	var root struct {
+		XMLName           xml.Name
+		EntityID          string                 `xml:"entityID,attr"`
+		IDPSSODescriptor  *samlIDPSSODescriptor  `xml:"IDPSSODescriptor"`
+		EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+	}
+	if err := xml.Unmarshal(metadata, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse SAML metadata: %w", err)
+	}
+
+	var entity *samlEntityDescriptor
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		entity = &samlEntityDescriptor{EntityID: root.EntityID, IDPSSODescriptor: root.IDPSSODescriptor}
+	case "EntitiesDescriptor":
+		for i := range root.EntityDescriptors {
+			if root.EntityDescriptors[i].IDPSSODescriptor != nil {
+				entity = &root.EntityDescriptors[i]
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected SAML metadata root element '%s'", root.XMLName.Local)
+	}
+	if entity == nil || entity.IDPSSODescriptor == nil {
+		return nil, fmt.Errorf("SAML metadata does not describe an identity provider")
+	}
+	if entity.EntityID == "" {
+		return nil, fmt.Errorf("SAML metadata is missing the entity ID")
+	}
+
+	result := &SAMLMetadataModel{
+		EntityID: entity.EntityID,
+	}
+	descriptor := entity.IDPSSODescriptor
+	for _, binding := range []string{samlHTTPPostBinding, samlHTTPRedirectBinding} {
+		for _, service := range descriptor.SingleSignOnService {
+			if service.Binding == binding && service.Location != "" {
+				result.SSOURL = service.Location
+				result.SSOBinding = binding
+				break
+			}
+		}
+		if result.SSOURL != "" {
+			break
+		}
+	}
+	if result.SSOURL == "" {
+		return nil, fmt.Errorf("SAML metadata has no HTTP-POST or HTTP-Redirect single sign-on service")
+	}
+	for _, service := range descriptor.SingleLogoutService {
+		if service.Location != "" {
+			result.LogoutURL = service.Location
+			break
+		}
+	}
+
+	for _, keyDescriptor := range descriptor.KeyDescriptors {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+		for _, cert := range keyDescriptor.X509Certificates {
+			cert = strings.Join(strings.Fields(cert), "")
+			der, err := base64.StdEncoding.DecodeString(cert)
+			if err != nil {
+				return nil, fmt.Errorf("invalid signing certificate in SAML metadata: %w", err)
+			}
+			if _, err := x509.ParseCertificate(der); err != nil {
+				return nil, fmt.Errorf("invalid signing certificate in SAML metadata: %w", err)
+			}
+			result.SigningCertificates = append(result.SigningCertificates, cert)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *IdentityProviderClient) fetchDocument(ctx context.Context, documentURL string, description string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request: %w", description, err)
+	}
+	resp, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", description, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", description, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", description, err)
+	}
+	return body, nil
+}
+
+func (c *IdentityProviderClient) callbackURL(override string) string {
+	if override != "" {
+		return override
+	}
+	return strings.TrimSuffix(c.config.BaseURL, "/") + "/commonauth"
+}
+
+func requireHTTPS(rawURL string, description string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%s is not in valid URL format: %w", description, err)
+	}
+	if parsedURL.Scheme != "https" || parsedURL.Host == "" {
+		return fmt.Errorf("%s must be an absolute https URL: %s", description, rawURL)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer starts a TLS server that serves the given documents and records identity provider creation
+func newTestServer(t *testing.T, documents map[string]func(serverURL string) string, created *IdentityProviderCreateModel) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/server/v1/identity-providers" {
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			id := "idp-id"
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(IdentityProviderResponseModel{Id: &id, Name: &created.Name})
+			return
+		}
+		document, ok := documents[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Empty(t, r.Header.Get("Authorization"), "documents must be fetched without credentials")
+		_, _ = w.Write([]byte(document(server.URL)))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *IdentityProviderClient {
+	cfg := config.DefaultClientConfig().
+		WithBaseURL(server.URL).
+		WithHTTPClient(server.Client()).
+		WithToken("test-token")
+	client, err := New(cfg)
+	require.NoError(t, err)
+	return client
+}
+
+func propertyMap(idp *IdentityProviderCreateModel) map[string]string {
+	properties := make(map[string]string)
+	for _, property := range *(*idp.FederatedAuthenticators.Authenticators)[0].Properties {
+		properties[property.Key] = *property.Value
+	}
+	return properties
+}
+
+func TestCreateFromOIDCDiscovery(t *testing.T) {
+	var created IdentityProviderCreateModel
+	server := newTestServer(t, map[string]func(string) string{
+		"/okta/.well-known/openid-configuration": func(serverURL string) string {
+			issuer := serverURL + "/okta"
+			return fmt.Sprintf(`{
+				"issuer": %q,
+				"authorization_endpoint": %q,
+				"token_endpoint": %q,
+				"userinfo_endpoint": %q,
+				"jwks_uri": %q
+			}`, issuer, issuer+"/v1/authorize", issuer+"/v1/token", issuer+"/v1/userinfo", issuer+"/v1/keys")
+		},
+	}, &created)
+	client := newTestClient(t, server)
+
+	idp, err := client.CreateFromOIDCDiscovery(context.Background(), "Okta", server.URL+"/okta/", "client-id", "client-secret", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "idp-id", *idp.Id)
+
+	issuer := server.URL + "/okta"
+	assert.Equal(t, "Okta", created.Name)
+	assert.Equal(t, issuer, *created.IdpIssuerName)
+	assert.Equal(t, issuer+"/v1/keys", *created.Certificate.JwksUri)
+	assert.Equal(t, FederatedAuthenticatorIDs.OIDC, created.FederatedAuthenticators.DefaultAuthenticatorId)
+	assert.Equal(t, map[string]string{
+		"ClientId":         "client-id",
+		"ClientSecret":     "client-secret",
+		"OAuth2AuthzEPUrl": issuer + "/v1/authorize",
+		"OAuth2TokenEPUrl": issuer + "/v1/token",
+		"UserInfoUrl":      issuer + "/v1/userinfo",
+		"callbackUrl":      server.URL + "/commonauth",
+		"Scopes":           "openid email profile",
+	}, propertyMap(&created))
+}
+
+func TestCreateFromOIDCDiscoveryRejectsInvalidDocuments(t *testing.T) {
+	server := newTestServer(t, map[string]func(string) string{
+		"/other/.well-known/openid-configuration": func(serverURL string) string {
+			return `{"issuer": "https://attacker.example.com", "authorization_endpoint": "a", "token_endpoint": "t", "jwks_uri": "j"}`
+		},
+		"/partial/.well-known/openid-configuration": func(serverURL string) string {
+			return fmt.Sprintf(`{"issuer": %q}`, serverURL+"/partial")
+		},
+	}, &IdentityProviderCreateModel{})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	_, err := client.CreateFromOIDCDiscovery(ctx, "Other", server.URL+"/other", "client-id", "client-secret", nil)
+	assert.ErrorContains(t, err, "does not match")
+
+	_, err = client.CreateFromOIDCDiscovery(ctx, "Partial", server.URL+"/partial", "client-id", "client-secret", nil)
+	assert.ErrorContains(t, err, "missing the authorization, token or JWKS endpoint")
+
+	_, err = client.CreateFromOIDCDiscovery(ctx, "Missing", server.URL+"/missing", "client-id", "client-secret", nil)
+	assert.ErrorContains(t, err, "status 404")
+
+	_, err = client.CreateFromOIDCDiscovery(ctx, "Plain", "http://example.com", "client-id", "client-secret", nil)
+	assert.ErrorContains(t, err, "must be an absolute https URL")
+}
+
+func TestCreateFromSAMLMetadata(t *testing.T) {
+	var created IdentityProviderCreateModel
+	server := newTestServer(t, nil, &created)
+	certDER := server.Certificate().Raw
+	certBase64 := base64.StdEncoding.EncodeToString(certDER)
+	metadata := fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://sts.windows.net/tenant-id/">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>not-a-signing-cert</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+        %s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.example.com/logout"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.example.com/redirect"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://login.example.com/post"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, certBase64)
+
+	client := newTestClient(t, server)
+	_, err := client.CreateFromSAMLMetadata(context.Background(), "Azure AD", metadata, &EnterpriseConnectionOptionsModel{SPEntityID: "asgardeo-sp"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://sts.windows.net/tenant-id/", *created.IdpIssuerName)
+	assert.Equal(t, FederatedAuthenticatorIDs.SAML, created.FederatedAuthenticators.DefaultAuthenticatorId)
+	assert.Equal(t, map[string]string{
+		"IdPEntityId":     "https://sts.windows.net/tenant-id/",
+		"SPEntityId":      "asgardeo-sp",
+		"SSOUrl":          "https://login.example.com/post",
+		"RequestMethod":   "post",
+		"IsLogoutEnabled": "true",
+		"LogoutReqUrl":    "https://login.example.com/logout",
+		"callbackUrl":     server.URL + "/commonauth",
+	}, propertyMap(&created))
+
+	require.NotNil(t, created.Certificate)
+	require.Len(t, *created.Certificate.Certificates, 1)
+	pemCert, err := base64.StdEncoding.DecodeString((*created.Certificate.Certificates)[0])
+	require.NoError(t, err)
+	block, _ := pem.Decode(pemCert)
+	require.NotNil(t, block)
+	assert.Equal(t, certDER, block.Bytes)
+}
+
+func TestCreateFromSAMLMetadataURL(t *testing.T) {
+	var created IdentityProviderCreateModel
+	server := newTestServer(t, map[string]func(string) string{
+		"/metadata": func(serverURL string) string {
+			return `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>
+  <EntityDescriptor entityID="https://idp.example.com">
+    <IDPSSODescriptor>
+      <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+    </IDPSSODescriptor>
+  </EntityDescriptor>
+</EntitiesDescriptor>`
+		},
+	}, &created)
+
+	client := newTestClient(t, server)
+	_, err := client.CreateFromSAMLMetadata(context.Background(), "Okta SAML", server.URL+"/metadata", nil)
+	require.NoError(t, err)
+
+	properties := propertyMap(&created)
+	assert.Equal(t, "https://idp.example.com", properties["IdPEntityId"])
+	assert.Equal(t, "https://idp.example.com/sso", properties["SSOUrl"])
+	assert.Equal(t, "redirect", properties["RequestMethod"])
+	assert.Equal(t, server.URL, properties["SPEntityId"])
+	assert.Nil(t, created.Certificate)
+}
+
+func TestParseSAMLMetadataErrors(t *testing.T) {
+	_, err := ParseSAMLMetadata([]byte(`<EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>`))
+	assert.ErrorContains(t, err, "does not describe an identity provider")
+
+	_, err = ParseSAMLMetadata([]byte(`<EntityDescriptor entityID="x"><IDPSSODescriptor>
+		<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP" Location="https://idp.example.com/soap"/>
+	</IDPSSODescriptor></EntityDescriptor>`))
+	assert.ErrorContains(t, err, "no HTTP-POST or HTTP-Redirect single sign-on service")
+
+	_, err = ParseSAMLMetadata([]byte(`<EntityDescriptor entityID="x"><IDPSSODescriptor>
+		<KeyDescriptor><KeyInfo><X509Data><X509Certificate>bm90IGEgY2VydA==</X509Certificate></X509Data></KeyInfo></KeyDescriptor>
+		<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso"/>
+	</IDPSSODescriptor></EntityDescriptor>`))
+	assert.ErrorContains(t, err, "invalid signing certificate")
+
+	_, err = ParseSAMLMetadata([]byte(`<Other/>`))
+	assert.ErrorContains(t, err, "unexpected SAML metadata root element")
+}
+
@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+// GetFederatedAuthenticators lists the federated authenticators of an identity provider.
+func (c *IdentityProviderClient) GetFederatedAuthenticators(ctx context.Context, idpId string) (*FederatedAuthenticatorListResponseModel, error) {
+	resp, err := c.apiClient.GetFederatedAuthenticatorsWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated authenticators: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get federated authenticators: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetFederatedAuthenticator retrieves the configuration of a federated authenticator of an identity provider.
+func (c *IdentityProviderClient) GetFederatedAuthenticator(ctx context.Context, idpId string, authenticatorId string) (*FederatedAuthenticatorConfigModel, error) {
+	resp, err := c.apiClient.GetFederatedAuthenticatorWithResponse(ctx, idpId, authenticatorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated authenticator: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get federated authenticator: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	config := convertToFederatedAuthenticatorConfig(resp.JSON200)
+	// The meta schema is only used to mark confidential properties, so a missing schema is not an error
+	if meta, err := c.GetMetaFederatedAuthenticator(ctx, authenticatorId); err == nil {
+		config.confidentialKeys = confidentialKeys(meta.Properties)
+	}
+	return config, nil
+}
+
+// UpdateFederatedAuthenticator updates the configuration of a federated authenticator of an identity provider
+// after validating its properties against the authenticator's meta schema.
+func (c *IdentityProviderClient) UpdateFederatedAuthenticator(ctx context.Context, idpId string, config *FederatedAuthenticatorConfigModel) (*FederatedAuthenticatorConfigModel, error) {
+	if err := c.validateFederatedAuthenticatorConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.apiClient.UpdateFederatedAuthenticatorWithResponse(ctx, idpId, config.AuthenticatorId, internal.FederatedAuthenticatorPUTRequest{
+		AuthenticatorId: &config.AuthenticatorId,
+		IsEnabled:       &config.IsEnabled,
+		IsDefault:       &config.IsDefault,
+		Properties:      convertToProperties(config.Properties),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update federated authenticator: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update federated authenticator: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	updated := convertToFederatedAuthenticatorConfig(resp.JSON200)
+	updated.confidentialKeys = config.confidentialKeys
+	return updated, nil
+}
+
+// UpdateFederatedAuthenticators replaces the federated authenticators of an identity provider after validating
+// each against its meta schema. The default authenticator must be one of the given authenticators.
+func (c *IdentityProviderClient) UpdateFederatedAuthenticators(ctx context.Context, idpId string, defaultAuthenticatorId string,
+	configs []FederatedAuthenticatorConfigModel) (*FederatedAuthenticatorListResponseModel, error) {
+	authenticators := make([]internal.FederatedAuthenticator, 0, len(configs))
+	hasDefault := false
+	for i := range configs {
+		config := &configs[i]
+		if err := c.validateFederatedAuthenticatorConfig(ctx, config); err != nil {
+			return nil, err
+		}
+		isDefault := config.AuthenticatorId == defaultAuthenticatorId
+		hasDefault = hasDefault || isDefault
+		isEnabled := config.IsEnabled
+		authenticators = append(authenticators, internal.FederatedAuthenticator{
+			AuthenticatorId: config.AuthenticatorId,
+			IsEnabled:       &isEnabled,
+			IsDefault:       &isDefault,
+			Properties:      convertToProperties(config.Properties),
+		})
+	}
+	if !hasDefault {
+		return nil, fmt.Errorf("default authenticator '%s' is not one of the given authenticators", defaultAuthenticatorId)
+	}
+
+	resp, err := c.apiClient.UpdateFederatedAuthenticatorsWithResponse(ctx, idpId, internal.FederatedAuthenticatorRequest{
+		DefaultAuthenticatorId: defaultAuthenticatorId,
+		Authenticators:         &authenticators,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update federated authenticators: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update federated authenticators: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetMetaFederatedAuthenticators lists the federated authenticators supported by the server.
+func (c *IdentityProviderClient) GetMetaFederatedAuthenticators(ctx context.Context) (*[]MetaFederatedAuthenticatorListItemModel, error) {
+	resp, err := c.apiClient.GetMetaFederatedAuthenticatorsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated authenticator metadata: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get federated authenticator metadata: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetMetaFederatedAuthenticator retrieves the property schema of a federated authenticator.
+func (c *IdentityProviderClient) GetMetaFederatedAuthenticator(ctx context.Context, authenticatorId string) (*MetaFederatedAuthenticatorModel, error) {
+	resp, err := c.apiClient.GetMetaFederatedAuthenticatorWithResponse(ctx, authenticatorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get federated authenticator metadata: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get federated authenticator metadata: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// ValidateFederatedAuthenticatorProperties checks properties against the meta schema of a federated authenticator.
+func ValidateFederatedAuthenticatorProperties(meta *MetaFederatedAuthenticatorModel, properties FederatedAuthenticatorProperties) error {
+	if meta == nil {
+		return nil
+	}
+	_, err := checkMetaProperties("federated authenticator", meta.Name, meta.Properties, properties)
+	return err
+}
+
+// MaskedProperties returns a copy of the properties with confidential values masked
+func (m FederatedAuthenticatorConfigModel) MaskedProperties() FederatedAuthenticatorProperties {
+	return maskProperties(m.Properties, m.confidentialKeys)
+}
+
+// String renders the configuration with confidential properties masked
+func (m FederatedAuthenticatorConfigModel) String() string {
+	return formatConfig(m.fields(), m.MaskedProperties())
+}
+
+// GoString renders the configuration for %#v with confidential properties masked
+func (m FederatedAuthenticatorConfigModel) GoString() string {
+	return "identity_provider.FederatedAuthenticatorConfigModel" + m.String()
+}
+
+// MarshalJSON encodes the configuration with confidential properties masked
+func (m FederatedAuthenticatorConfigModel) MarshalJSON() ([]byte, error) {
+	type config FederatedAuthenticatorConfigModel
+	masked := config(m)
+	masked.Properties = m.MaskedProperties()
+	return json.Marshal(masked)
+}
+
+// LogValue renders the configuration for structured logging with confidential properties masked
+func (m FederatedAuthenticatorConfigModel) LogValue() slog.Value {
+	return configLogValue(m.fields(), m.MaskedProperties())
+}
+
+func (m FederatedAuthenticatorConfigModel) fields() []slog.Attr {
+	return []slog.Attr{
+		slog.String("AuthenticatorId", m.AuthenticatorId),
+		slog.String("Name", m.Name),
+		slog.Bool("IsEnabled", m.IsEnabled),
+		slog.Bool("IsDefault", m.IsDefault),
+	}
+}
+
+func (c *IdentityProviderClient) validateFederatedAuthenticatorConfig(ctx context.Context, config *FederatedAuthenticatorConfigModel) error {
+	if config == nil || config.AuthenticatorId == "" {
+		return fmt.Errorf("federated authenticator ID is required")
+	}
+	meta, err := c.GetMetaFederatedAuthenticator(ctx, config.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	keys, err := checkMetaProperties("federated authenticator", meta.Name, meta.Properties, config.Properties)
+	if err != nil {
+		return err
+	}
+	config.confidentialKeys = keys
+	return nil
+}
+
+func convertToFederatedAuthenticatorConfig(authenticator *internal.FederatedAuthenticator) *FederatedAuthenticatorConfigModel {
+	config := &FederatedAuthenticatorConfigModel{}
+	if authenticator == nil {
+		config.Properties = FederatedAuthenticatorProperties{}
+		return config
+	}
+	config.AuthenticatorId = authenticator.AuthenticatorId
+	if authenticator.Name != nil {
+		config.Name = *authenticator.Name
+	}
+	if authenticator.IsEnabled != nil {
+		config.IsEnabled = *authenticator.IsEnabled
+	}
+	if authenticator.IsDefault != nil {
+		config.IsDefault = *authenticator.IsDefault
+	}
+	config.Properties = propertiesFromList(authenticator.Properties)
+	return config
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAuthenticatorMeta() *MetaFederatedAuthenticatorModel {
+	boolType := internal.BOOLEAN
+	intType := internal.INTEGER
+	yes := true
+	name := "OpenIDConnectAuthenticator"
+	pattern := `^https://`
+	return &MetaFederatedAuthenticatorModel{
+		Name: &name,
+		Properties: &[]MetaPropertyModel{
+			{Key: "ClientId", IsMandatory: &yes},
+			{Key: "ClientSecret", IsMandatory: &yes, IsConfidential: &yes},
+			{Key: "OAuth2TokenEPUrl", Regex: &pattern},
+			{Key: "IsBasicAuthEnabled", Type: &boolType, SubProperties: &[]MetaPropertyModel{
+				{Key: "Timeout", Type: &intType},
+			}},
+			{Key: "ResponseMode", Options: &[]string{"query", "form_post"}},
+		},
+	}
+}
+
+func TestValidateFederatedAuthenticatorProperties(t *testing.T) {
+	meta := testAuthenticatorMeta()
+
+	require.NoError(t, ValidateFederatedAuthenticatorProperties(meta, FederatedAuthenticatorProperties{
+		"ClientId":           "client",
+		"ClientSecret":       "secret",
+		"OAuth2TokenEPUrl":   "https://idp.example.com/token",
+		"IsBasicAuthEnabled": "true",
+		"Timeout":            "30",
+		"ResponseMode":       "query",
+		"CustomProperty":     "allowed",
+	}))
+
+	err := ValidateFederatedAuthenticatorProperties(meta, FederatedAuthenticatorProperties{
+		"ClientId":           "client",
+		"OAuth2TokenEPUrl":   "http://idp.example.com/token",
+		"IsBasicAuthEnabled": "yes",
+		"Timeout":            "soon",
+		"ResponseMode":       "fragment",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'ClientSecret' is required")
+	assert.Contains(t, err.Error(), "'OAuth2TokenEPUrl' does not match")
+	assert.Contains(t, err.Error(), "'IsBasicAuthEnabled' must be a boolean")
+	assert.Contains(t, err.Error(), "'Timeout' must be an integer")
+	assert.Contains(t, err.Error(), "'ResponseMode' must be one of [query, form_post]")
+}
+
+func TestValidateFederatedAuthenticatorPropertiesHidesConfidentialValues(t *testing.T) {
+	meta := testAuthenticatorMeta()
+	(*meta.Properties)[1].Options = &[]string{"a", "b"}
+
+	err := ValidateFederatedAuthenticatorProperties(meta, FederatedAuthenticatorProperties{
+		"ClientId":     "client",
+		"ClientSecret": "super-secret",
+	})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "super-secret")
+
+	// Keys naming a credential are hidden even when the meta schema does not flag them
+	(*meta.Properties)[1].IsConfidential = nil
+	err = ValidateFederatedAuthenticatorProperties(meta, FederatedAuthenticatorProperties{
+		"ClientId":     "client",
+		"ClientSecret": "super-secret",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "'ClientSecret' must be one of [a, b] but has value")
+	assert.NotContains(t, err.Error(), "super-secret")
+}
+
+func TestFederatedAuthenticatorConfigMasksConfidentialProperties(t *testing.T) {
+	config := FederatedAuthenticatorConfigModel{
+		AuthenticatorId: FederatedAuthenticatorIDs.OIDC,
+		Properties: FederatedAuthenticatorProperties{
+			"ClientId":     "client",
+			"ClientSecret": "super-secret",
+			"SigningKey":   "key-material",
+		},
+		confidentialKeys: confidentialKeys(&[]MetaPropertyModel{{Key: "SigningKey", IsConfidential: boolPtr(true)}}),
+	}
+
+	for _, rendered := range []string{
+		fmt.Sprint(config),
+		fmt.Sprintf("%+v", config),
+		fmt.Sprintf("%#v", config),
+		fmt.Sprintf("%v", &config),
+	} {
+		assert.Contains(t, rendered, "ClientId=client")
+		assert.NotContains(t, rendered, "super-secret")
+		assert.NotContains(t, rendered, "key-material")
+	}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("updating", "authenticator", config)
+	assert.Contains(t, logs.String(), "ClientSecret:"+maskedPropertyValue)
+	assert.NotContains(t, logs.String(), "super-secret")
+	assert.NotContains(t, logs.String(), "key-material")
+
+	assert.Equal(t, "super-secret", config.Properties["ClientSecret"])
+}
+
+func TestFederatedAuthenticatorConfigMarshalsMaskedProperties(t *testing.T) {
+	config := FederatedAuthenticatorConfigModel{
+		AuthenticatorId: FederatedAuthenticatorIDs.OIDC,
+		IsEnabled:       true,
+		Properties: FederatedAuthenticatorProperties{
+			"ClientId":     "client",
+			"ClientSecret": "super-secret",
+			"SigningKey":   "key-material",
+		},
+		confidentialKeys: confidentialKeys(&[]MetaPropertyModel{{Key: "SigningKey", IsConfidential: boolPtr(true)}}),
+	}
+
+	for _, value := range []interface{}{config, &config} {
+		encoded, err := json.Marshal(value)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"authenticatorId": "`+FederatedAuthenticatorIDs.OIDC+`", "isEnabled": true, "isDefault": false,
+			"properties": {"ClientId": "client", "ClientSecret": "********", "SigningKey": "********"}}`, string(encoded))
+	}
+	assert.Equal(t, "super-secret", config.Properties["ClientSecret"])
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
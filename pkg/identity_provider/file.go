@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"gopkg.in/yaml.v2"
+)
+
+var secretPlaceholderPattern = regexp.MustCompile(`\$\{secret:([^/}]*)/([^}]+)\}`)
+
+// Export exports an identity provider in the given format. Secrets are redacted unless excludeSecrets is explicitly
+// set to false. In JSON and YAML exports each redacted secret is replaced with a placeholder that can be filled in
+// by a SecretResolver on import. XML exports rely on the server to leave out secrets.
+func (c *IdentityProviderClient) Export(ctx context.Context, id string, format IdentityProviderFileFormat, excludeSecrets *bool) ([]byte, error) {
+	exclude := excludeSecrets == nil || *excludeSecrets
+	accept := internal.ExportIDPToFileParamsAccept(format)
+	resp, err := c.apiClient.ExportIDPToFile(ctx, id, &internal.ExportIDPToFileParams{
+		ExcludeSecrets: &exclude,
+		Accept:         &accept,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export identity provider: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exported identity provider: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to export identity provider: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	if !exclude || format == FileFormatXML {
+		return body, nil
+	}
+	return redactSecrets(body, format)
+}
+
+// ImportFromFile creates an identity provider from an exported file and returns the ID of the created identity
+// provider. Secret placeholders in JSON and YAML files are replaced with values from the resolver.
+func (c *IdentityProviderClient) ImportFromFile(ctx context.Context, file io.Reader, format IdentityProviderFileFormat, resolver SecretResolver) (string, error) {
+	contentType, body, err := buildIdentityProviderUpload(file, format, resolver)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.apiClient.ImportIDPFromFileWithBodyWithResponse(ctx, contentType, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to import identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("failed to import identity provider: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	location := resp.HTTPResponse.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("failed to import identity provider: missing location of created identity provider")
+	}
+	return path.Base(strings.TrimSuffix(location, "/")), nil
+}
+
+// UpdateFromFile replaces an identity provider with the contents of an exported file. Secret placeholders in JSON
+// and YAML files are replaced with values from the resolver.
+func (c *IdentityProviderClient) UpdateFromFile(ctx context.Context, id string, file io.Reader, format IdentityProviderFileFormat, resolver SecretResolver) error {
+	contentType, body, err := buildIdentityProviderUpload(file, format, resolver)
+	if err != nil {
+		return err
+	}
+	resp, err := c.apiClient.UpdateIDPFromFileWithBodyWithResponse(ctx, id, contentType, body)
+	if err != nil {
+		return fmt.Errorf("failed to update identity provider from file: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update identity provider from file: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// FindSecretPlaceholders lists the secret placeholders in an exported identity provider file
+func FindSecretPlaceholders(file []byte) []SecretPlaceholderModel {
+	var placeholders []SecretPlaceholderModel
+	seen := map[string]struct{}{}
+	for _, match := range secretPlaceholderPattern.FindAllStringSubmatch(string(file), -1) {
+		if _, ok := seen[match[0]]; ok {
+			continue
+		}
+		seen[match[0]] = struct{}{}
+		placeholders = append(placeholders, SecretPlaceholderModel{Placeholder: match[0], Owner: match[1], Property: match[2]})
+	}
+	return placeholders
+}
+
+// buildIdentityProviderUpload resolves secret placeholders and encodes the file as a multipart upload
+func buildIdentityProviderUpload(file io.Reader, format IdentityProviderFileFormat, resolver SecretResolver) (string, io.Reader, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read identity provider file: %w", err)
+	}
+	content, err = resolveSecrets(content, format, resolver)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="identity-provider.%s"`, fileExtension(format)))
+	header.Set("Content-Type", string(format))
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode identity provider file: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return "", nil, fmt.Errorf("failed to encode identity provider file: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", nil, fmt.Errorf("failed to encode identity provider file: %w", err)
+	}
+	return writer.FormDataContentType(), &body, nil
+}
+
+// resolveSecrets replaces every secret placeholder with the value returned by the resolver
+func resolveSecrets(content []byte, format IdentityProviderFileFormat, resolver SecretResolver) ([]byte, error) {
+	placeholders := FindSecretPlaceholders(content)
+	if len(placeholders) == 0 {
+		return content, nil
+	}
+	if resolver == nil {
+		names := make([]string, 0, len(placeholders))
+		for _, placeholder := range placeholders {
+			names = append(names, placeholder.Owner+"/"+placeholder.Property)
+		}
+		return nil, fmt.Errorf("identity provider file has unresolved secrets: %s", strings.Join(names, ", "))
+	}
+
+	values := make(map[string]string, len(placeholders))
+	for _, placeholder := range placeholders {
+		value, err := resolver(placeholder)
+		if err != nil {
+			// The resolver error is not wrapped since it may contain the secret
+			return nil, fmt.Errorf("failed to resolve secret %s/%s", placeholder.Owner, placeholder.Property)
+		}
+		values[placeholder.Placeholder] = value
+	}
+
+	var resolveErr error
+	resolved := secretPlaceholderPattern.ReplaceAllStringFunc(string(content), func(placeholder string) string {
+		encoded, err := encodeSecretValue(values[placeholder], format)
+		if err != nil && resolveErr == nil {
+			resolveErr = err
+		}
+		return encoded
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return []byte(resolved), nil
+}
+
+// encodeSecretValue escapes a secret for use inside a string value of the given format
+func encodeSecretValue(value string, format IdentityProviderFileFormat) (string, error) {
+	switch format {
+	case FileFormatJSON:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode secret value")
+		}
+		return string(encoded[1 : len(encoded)-1]), nil
+	case FileFormatXML:
+		var escaped bytes.Buffer
+		if err := xml.EscapeText(&escaped, []byte(value)); err != nil {
+			return "", fmt.Errorf("failed to encode secret value")
+		}
+		return escaped.String(), nil
+	default:
+		// Placeholders are written as double quoted YAML scalars, which use JSON compatible escapes
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode secret value")
+		}
+		return string(encoded[1 : len(encoded)-1]), nil
+	}
+}
+
+// redactSecrets replaces the values of confidential properties with placeholders
+func redactSecrets(content []byte, format IdentityProviderFileFormat) ([]byte, error) {
+	if format == FileFormatJSON {
+		var document interface{}
+		if err := json.Unmarshal(content, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse exported identity provider: %w", err)
+		}
+		document = redactNode(document, "")
+		redacted, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode exported identity provider: %w", err)
+		}
+		return redacted, nil
+	}
+
+	var document yaml.MapSlice
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse exported identity provider: %w", err)
+	}
+	redacted, err := yaml.Marshal(redactNode(document, ""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode exported identity provider: %w", err)
+	}
+	// Placeholders are double quoted so that resolved secrets can be escaped consistently
+	return secretPlaceholderScalarPattern.ReplaceAll(redacted, []byte(`"$1"`)), nil
+}
+
+var secretPlaceholderScalarPattern = regexp.MustCompile(`(?m)'?(\$\{secret:[^}]+\})'?$`)
+
+// redactNode walks a decoded document and redacts confidential properties. A property is an object with a name
+// and a value, and its owner is the name of the object holding the property list.
+func redactNode(node interface{}, owner string) interface{} {
+	switch typed := node.(type) {
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactNode(item, owner)
+		}
+		return typed
+	case map[string]interface{}:
+		if name, isProperty := propertyName(typed["name"], typed); isProperty {
+			if needsSecretPlaceholder(name, typed["confidential"], typed["value"]) {
+				typed["value"] = secretPlaceholder(owner, name)
+			}
+			return typed
+		}
+		childOwner := owner
+		if name, ok := typed["name"].(string); ok {
+			childOwner = name
+		}
+		for key, value := range typed {
+			typed[key] = redactNode(value, childOwner)
+		}
+		return typed
+	case yaml.MapSlice:
+		values := map[string]interface{}{}
+		for _, item := range typed {
+			if key, ok := item.Key.(string); ok {
+				values[key] = item.Value
+			}
+		}
+		if name, isProperty := propertyName(values["name"], values); isProperty {
+			if needsSecretPlaceholder(name, values["confidential"], values["value"]) {
+				placeholder := secretPlaceholder(owner, name)
+				replaced := false
+				for i := range typed {
+					if typed[i].Key == "value" {
+						typed[i].Value = placeholder
+						replaced = true
+					}
+				}
+				if !replaced {
+					typed = append(typed, yaml.MapItem{Key: "value", Value: placeholder})
+				}
+			}
+			return typed
+		}
+		childOwner := owner
+		if name, ok := values["name"].(string); ok {
+			childOwner = name
+		}
+		for i := range typed {
+			typed[i].Value = redactNode(typed[i].Value, childOwner)
+		}
+		return typed
+	default:
+		return node
+	}
+}
+
+// propertyName reports whether an object is a property, which has a name and a value or a confidential flag
+func propertyName(name interface{}, values map[string]interface{}) (string, bool) {
+	nameString, ok := name.(string)
+	if !ok {
+		return "", false
+	}
+	_, hasValue := values["value"]
+	_, hasConfidential := values["confidential"]
+	if !hasValue && !hasConfidential {
+		return "", false
+	}
+	for _, value := range values {
+		switch value.(type) {
+		case []interface{}, map[string]interface{}, yaml.MapSlice:
+			return "", false
+		}
+	}
+	return nameString, true
+}
+
+// needsSecretPlaceholder reports whether the value of a property is replaced with a placeholder. Properties flagged
+// confidential are exported without their values and always get one, while properties that are confidential only
+// by name get one when they have a value.
+func needsSecretPlaceholder(name string, confidential interface{}, value interface{}) bool {
+	flagged := false
+	switch flag := confidential.(type) {
+	case bool:
+		flagged = flag
+	case string:
+		flagged = strings.EqualFold(flag, "true")
+	}
+	if flagged {
+		return true
+	}
+	return value != nil && value != "" && isConfidential(name, false)
+}
+
+func secretPlaceholder(owner string, property string) string {
+	return "${secret:" + owner + "/" + property + "}"
+}
+
+func fileExtension(format IdentityProviderFileFormat) string {
+	switch format {
+	case FileFormatJSON:
+		return "json"
+	case FileFormatXML:
+		return "xml"
+	default:
+		return "yaml"
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportedYAML = `identityProviderName: Keycloak
+federatedAuthenticatorConfigs:
+- name: OpenIDConnectAuthenticator
+  enabled: true
+  properties:
+  - name: ClientId
+    value: client
+    confidential: false
+  - name: ClientSecret
+    value: top-secret
+    confidential: true
+  - name: PrivateKey
+    value: key-material
+    confidential: false
+  - name: TokenEndpointAuthSecret
+    confidential: false
+`
+
+const exportedJSON = `{
+  "identityProviderName": "Keycloak",
+  "federatedAuthenticatorConfigs": [
+    {
+      "name": "OpenIDConnectAuthenticator",
+      "properties": [
+        {"name": "ClientId", "value": "client", "confidential": false},
+        {"name": "ClientSecret", "value": "", "confidential": true}
+      ]
+    }
+  ]
+}`
+
+// newFileTestServer serves exports and records uploaded files
+func newFileTestServer(t *testing.T, uploaded *string) *IdentityProviderClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/server/v1/identity-providers/idp-1/export":
+			assert.Equal(t, "true", r.URL.Query().Get("excludeSecrets"))
+			w.Header().Set("Content-Type", r.Header.Get("Accept"))
+			if r.Header.Get("Accept") == string(FileFormatJSON) {
+				_, _ = io.WriteString(w, exportedJSON)
+			} else {
+				_, _ = io.WriteString(w, exportedYAML)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/server/v1/identity-providers/import":
+			file, header, err := r.FormFile("file")
+			if !assert.NoError(t, err) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			assert.Equal(t, "identity-provider.yaml", header.Filename)
+			content, _ := io.ReadAll(file)
+			*uploaded = string(content)
+			w.Header().Set("Location", "https://localhost/api/server/v1/identity-providers/idp-2")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func TestExportRedactsSecretsWithPlaceholders(t *testing.T) {
+	client := newFileTestServer(t, nil)
+
+	exported, err := client.Export(context.Background(), "idp-1", FileFormatYAML, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, string(exported), "top-secret")
+	assert.NotContains(t, string(exported), "key-material")
+	assert.Contains(t, string(exported), "value: client")
+	assert.Contains(t, string(exported), `value: "${secret:OpenIDConnectAuthenticator/ClientSecret}"`)
+	assert.Contains(t, string(exported), `value: "${secret:OpenIDConnectAuthenticator/PrivateKey}"`)
+	// Properties that are confidential only by name and have no value are left without a placeholder
+	assert.NotContains(t, string(exported), "TokenEndpointAuthSecret}")
+
+	exported, err = client.Export(context.Background(), "idp-1", FileFormatJSON, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []SecretPlaceholderModel{{
+		Placeholder: "${secret:OpenIDConnectAuthenticator/ClientSecret}",
+		Owner:       "OpenIDConnectAuthenticator",
+		Property:    "ClientSecret",
+	}}, FindSecretPlaceholders(exported))
+}
+
+func TestImportFromFileResolvesPlaceholders(t *testing.T) {
+	var uploaded string
+	client := newFileTestServer(t, &uploaded)
+
+	exported, err := client.Export(context.Background(), "idp-1", FileFormatYAML, nil)
+	require.NoError(t, err)
+
+	_, err = client.ImportFromFile(context.Background(), strings.NewReader(string(exported)), FileFormatYAML, nil)
+	assert.ErrorContains(t, err, "unresolved secrets: OpenIDConnectAuthenticator/ClientSecret, OpenIDConnectAuthenticator/PrivateKey")
+
+	_, err = client.ImportFromFile(context.Background(), strings.NewReader(string(exported)), FileFormatYAML,
+		func(secret SecretPlaceholderModel) (string, error) {
+			return "", fmt.Errorf("vault lookup of s3cr3t failed")
+		})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "s3cr3t")
+
+	id, err := client.ImportFromFile(context.Background(), strings.NewReader(string(exported)), FileFormatYAML,
+		func(secret SecretPlaceholderModel) (string, error) {
+			return `new"secret-` + secret.Property, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "idp-2", id)
+	assert.Contains(t, uploaded, `value: "new\"secret-ClientSecret"`)
+	assert.NotContains(t, uploaded, "${secret:")
+}
@@ -19,9 +19,230 @@
 package identity_provider
 
 import (
+	"context"
+
 	"github.com/asgardeo/go/pkg/identity_provider/internal"
 )
 
 type IdentityProviderListParamsModel = internal.GetIDPsParams
 
 type IdentityProviderListResponseModel = internal.IdentityProviderListResponse
+
+type IdentityProviderListItemModel = internal.IdentityProviderListItem
+
+type IdentityProviderCreateModel = internal.IdentityProviderPOSTRequest
+
+type IdentityProviderResponseModel = internal.IdentityProviderResponse
+
+type IdentityProviderPatchModel = internal.Patch
+
+type IdentityProviderPatchOperation = internal.PatchOperation
+
+// Patch operations as typed constants
+const (
+	PatchOperationAdd     IdentityProviderPatchOperation = internal.ADD
+	PatchOperationRemove  IdentityProviderPatchOperation = internal.REMOVE
+	PatchOperationReplace IdentityProviderPatchOperation = internal.REPLACE
+)
+
+type ConnectedAppModel = internal.ConnectedApp
+
+// IdentityProviderDeleteOptionsModel controls the deletion of an identity provider
+type IdentityProviderDeleteOptionsModel struct {
+	// Force deletes the identity provider even if applications still use it in their login flow
+	Force bool
+}
+
+// SocialConnectionOptionsModel holds optional settings for the social login connection builders
+type SocialConnectionOptionsModel struct {
+	Description string
+	Image       string
+	// Scopes overrides the default scopes requested from the provider
+	Scopes []string
+	// CallbackURL overrides the default callback URL, which is the commonauth endpoint of the tenant
+	CallbackURL string
+	// AddToLoginFlow is called with the name and authenticator ID of the created connection, for example
+	// to add it to an application's login flow with ApplicationClient.AddIdentityProviderToLoginFlow
+	AddToLoginFlow func(ctx context.Context, idpName string, authenticatorId string) error
+	// AppleTeamID and AppleKeyID are required for Apple connections, whose secret is the private key
+	AppleTeamID string
+	AppleKeyID  string
+}
+
+// OIDCDiscoveryDocumentModel holds the endpoints read from an OpenID Provider's discovery document
+type OIDCDiscoveryDocumentModel struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	JwksURI               string   `json:"jwks_uri"`
+	EndSessionEndpoint    string   `json:"end_session_endpoint,omitempty"`
+	ScopesSupported       []string `json:"scopes_supported,omitempty"`
+}
+
+// SAMLMetadataModel holds the details read from a SAML identity provider's metadata
+type SAMLMetadataModel struct {
+	EntityID string
+	SSOURL   string
+	// SSOBinding is the binding of the selected SSO URL, either HTTP-POST or HTTP-Redirect
+	SSOBinding string
+	LogoutURL  string
+	// SigningCertificates are the base64 encoded DER signing certificates
+	SigningCertificates []string
+}
+
+// EnterpriseConnectionOptionsModel holds optional settings for OIDC and SAML enterprise connections
+type EnterpriseConnectionOptionsModel struct {
+	Description string
+	// Scopes overrides the scopes requested from an OIDC provider, which default to openid, email and profile
+	Scopes []string
+	// CallbackURL overrides the default callback URL, which is the commonauth endpoint of the tenant
+	CallbackURL string
+	// SPEntityID is the entity ID of Asgardeo registered at a SAML provider, defaulting to the tenant base URL
+	SPEntityID string
+}
+
+type FederatedAuthenticatorListResponseModel = internal.FederatedAuthenticatorListResponse
+
+type MetaFederatedAuthenticatorListItemModel = internal.MetaFederatedAuthenticatorListItem
+
+type MetaFederatedAuthenticatorModel = internal.MetaFederatedAuthenticator
+
+type MetaPropertyModel = internal.MetaProperty
+
+// FederatedAuthenticatorProperties maps property keys of a federated authenticator to their values
+type FederatedAuthenticatorProperties map[string]string
+
+// FederatedAuthenticatorConfigModel is the configuration of a federated authenticator of an identity provider.
+// Confidential properties are masked when the model is printed or logged.
+type FederatedAuthenticatorConfigModel struct {
+	AuthenticatorId string                           `json:"authenticatorId"`
+	Name            string                           `json:"name,omitempty"`
+	IsEnabled       bool                             `json:"isEnabled"`
+	IsDefault       bool                             `json:"isDefault"`
+	Properties      FederatedAuthenticatorProperties `json:"properties"`
+
+	confidentialKeys map[string]struct{}
+}
+
+type JITConfigModel = internal.JustInTimeProvisioning
+
+type JITProvisioningScheme = internal.JustInTimeProvisioningScheme
+
+type JITAttributeSyncMethod = internal.JustInTimeProvisioningAttributeSyncMethod
+
+// JIT provisioning schemes
+const (
+	JITSchemePromptConsent                 JITProvisioningScheme = internal.PROMPTCONSENT
+	JITSchemePromptPasswordConsent         JITProvisioningScheme = internal.PROMPTPASSWORDCONSENT
+	JITSchemePromptUsernamePasswordConsent JITProvisioningScheme = internal.PROMPTUSERNAMEPASSWORDCONSENT
+	JITSchemeProvisionSilently             JITProvisioningScheme = internal.PROVISIONSILENTLY
+)
+
+// JIT attribute sync methods
+const (
+	JITAttributeSyncNone          JITAttributeSyncMethod = internal.JustInTimeProvisioningAttributeSyncMethodNONE
+	JITAttributeSyncOverrideAll   JITAttributeSyncMethod = internal.JustInTimeProvisioningAttributeSyncMethodOVERRIDEALL
+	JITAttributeSyncPreserveLocal JITAttributeSyncMethod = internal.JustInTimeProvisioningAttributeSyncMethodPRESERVELOCAL
+)
+
+type RoleConfigModel = internal.Roles
+
+type RoleMappingModel = internal.RoleMapping
+
+type GroupConfigModel = internal.IdPGroupsConfig
+
+type IdPGroupModel = internal.IdPGroup
+
+type ClaimConfigModel = internal.Claims
+
+type ClaimMappingModel = internal.ClaimMapping
+
+type ProvisioningClaimModel = internal.ProvisioningClaim
+
+type ClaimModel = internal.Claim
+
+type OutboundConnectorListResponseModel = internal.OutboundConnectorListResponse
+
+type MetaOutboundConnectorListItemModel = internal.MetaOutboundConnectorListItem
+
+type MetaOutboundConnectorModel = internal.MetaOutboundConnector
+
+// OutboundConnectorProperties maps property keys of an outbound provisioning connector to their values
+type OutboundConnectorProperties map[string]string
+
+// OutboundConnectorConfigModel is the configuration of an outbound provisioning connector of an identity provider.
+// Confidential properties are masked when the model is printed or logged.
+type OutboundConnectorConfigModel struct {
+	ConnectorId     string                      `json:"connectorId"`
+	Name            string                      `json:"name,omitempty"`
+	IsEnabled       bool                        `json:"isEnabled"`
+	IsDefault       bool                        `json:"isDefault"`
+	BlockingEnabled bool                        `json:"blockingEnabled"`
+	RulesEnabled    bool                        `json:"rulesEnabled"`
+	Properties      OutboundConnectorProperties `json:"properties"`
+
+	confidentialKeys map[string]struct{}
+}
+
+type TrustedTokenIssuerCreateModel = internal.TrustedTokenIssuerPOSTRequest
+
+type TrustedTokenIssuerResponseModel = internal.TrustedTokenIssuerResponse
+
+type TrustedTokenIssuerListParamsModel = internal.GetTrustedTokenIssuersParams
+
+type CertificateModel = internal.Certificate
+
+// TrustedTokenIssuerOptionsModel holds optional settings for a trusted token issuer
+type TrustedTokenIssuerOptionsModel struct {
+	Description string
+	Image       string
+	// Alias is the audience value the issuer sets in its tokens to refer to Asgardeo, defaulting to the token endpoint
+	Alias string
+}
+
+type IdentityProviderTemplateModel = internal.IdentityProviderTemplate
+
+type IdentityProviderTemplateListItemModel = internal.IdentityProviderTemplateListItem
+
+type IdentityProviderTemplateListResponseModel = internal.IdentityProviderTemplateListResponse
+
+type IdentityProviderTemplateListParamsModel = internal.GetIDPTemplatesParams
+
+type IdentityProviderTemplateCategory = internal.IdentityProviderTemplateCategory
+
+// Identity provider template categories
+const (
+	TemplateCategoryCustom  IdentityProviderTemplateCategory = internal.IdentityProviderTemplateCategoryCUSTOM
+	TemplateCategoryDefault IdentityProviderTemplateCategory = internal.IdentityProviderTemplateCategoryDEFAULT
+)
+
+type IdentityProviderFileFormat = internal.FileTypeHeaderParam
+
+// File formats supported for identity provider export and import
+const (
+	FileFormatJSON IdentityProviderFileFormat = internal.FileTypeHeaderParamApplicationjson
+	FileFormatYAML IdentityProviderFileFormat = internal.FileTypeHeaderParamApplicationyaml
+	FileFormatXML  IdentityProviderFileFormat = internal.FileTypeHeaderParamApplicationxml
+)
+
+// SecretPlaceholderModel identifies a secret that was redacted from an exported identity provider
+type SecretPlaceholderModel struct {
+	// Placeholder is the value written in place of the secret, such as ${secret:GoogleOIDCAuthenticator/ClientSecret}
+	Placeholder string
+	// Owner is the name of the federated authenticator or provisioning connector the secret belongs to
+	Owner string
+	// Property is the key of the secret property
+	Property string
+}
+
+// SecretResolver returns the value of a redacted secret when an identity provider file is imported
+type SecretResolver func(secret SecretPlaceholderModel) (string, error)
+
+// FederatedAssociationConfigModel defines how federated users are linked to existing local accounts.
+// A federated user is linked to the local account whose lookup attributes match the federated user's attributes.
+type FederatedAssociationConfigModel struct {
+	IsEnabled bool `json:"isEnabled"`
+	// LookupAttributes are the local claim URIs used to find the local account, such as http://wso2.org/claims/emailaddress
+	LookupAttributes []string `json:"lookupAttribute"`
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+// GetOutboundConnectors lists the outbound provisioning connectors of an identity provider.
+func (c *IdentityProviderClient) GetOutboundConnectors(ctx context.Context, idpId string) (*OutboundConnectorListResponseModel, error) {
+	resp, err := c.apiClient.GetOutboundConnectorsWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound connectors: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get outbound connectors: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetOutboundConnector retrieves the configuration of an outbound provisioning connector of an identity provider.
+func (c *IdentityProviderClient) GetOutboundConnector(ctx context.Context, idpId string, connectorId string) (*OutboundConnectorConfigModel, error) {
+	resp, err := c.apiClient.GetOutboundConnectorWithResponse(ctx, idpId, connectorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound connector: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get outbound connector: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	config := convertToOutboundConnectorConfig(resp.JSON200)
+	// The meta schema is only used to mark confidential properties, so a missing schema is not an error
+	if meta, err := c.GetMetaOutboundConnector(ctx, connectorId); err == nil {
+		config.confidentialKeys = confidentialKeys(meta.Properties)
+	}
+	return config, nil
+}
+
+// UpdateOutboundConnector updates the configuration of an outbound provisioning connector of an identity provider
+// after validating its properties against the connector's meta schema.
+func (c *IdentityProviderClient) UpdateOutboundConnector(ctx context.Context, idpId string, config *OutboundConnectorConfigModel) (*OutboundConnectorConfigModel, error) {
+	if err := c.validateOutboundConnectorConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.apiClient.UpdateOutboundConnectorWithResponse(ctx, idpId, config.ConnectorId, internal.OutboundConnectorPUTRequest{
+		ConnectorId:     &config.ConnectorId,
+		IsEnabled:       &config.IsEnabled,
+		IsDefault:       &config.IsDefault,
+		BlockingEnabled: &config.BlockingEnabled,
+		RulesEnabled:    &config.RulesEnabled,
+		Properties:      convertToProperties(config.Properties),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update outbound connector: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update outbound connector: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	updated := convertToOutboundConnectorConfig(resp.JSON200)
+	updated.confidentialKeys = config.confidentialKeys
+	return updated, nil
+}
+
+// UpdateOutboundConnectors replaces the outbound provisioning connectors of an identity provider after validating
+// each against its meta schema. The default connector must be one of the given connectors.
+func (c *IdentityProviderClient) UpdateOutboundConnectors(ctx context.Context, idpId string, defaultConnectorId string,
+	configs []OutboundConnectorConfigModel) (*OutboundConnectorListResponseModel, error) {
+	connectors := make([]internal.OutboundConnector, 0, len(configs))
+	hasDefault := false
+	for i := range configs {
+		config := &configs[i]
+		if err := c.validateOutboundConnectorConfig(ctx, config); err != nil {
+			return nil, err
+		}
+		isDefault := config.ConnectorId == defaultConnectorId
+		hasDefault = hasDefault || isDefault
+		isEnabled, blockingEnabled, rulesEnabled := config.IsEnabled, config.BlockingEnabled, config.RulesEnabled
+		connectors = append(connectors, internal.OutboundConnector{
+			ConnectorId:     config.ConnectorId,
+			IsEnabled:       &isEnabled,
+			IsDefault:       &isDefault,
+			BlockingEnabled: &blockingEnabled,
+			RulesEnabled:    &rulesEnabled,
+			Properties:      convertToProperties(config.Properties),
+		})
+	}
+	if !hasDefault {
+		return nil, fmt.Errorf("default connector '%s' is not one of the given connectors", defaultConnectorId)
+	}
+
+	resp, err := c.apiClient.UpdateOutboundConnectorsWithResponse(ctx, idpId, internal.OutboundProvisioningRequest{
+		DefaultConnectorId: defaultConnectorId,
+		Connectors:         &connectors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update outbound connectors: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update outbound connectors: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetMetaOutboundConnectors lists the outbound provisioning connectors supported by the server.
+func (c *IdentityProviderClient) GetMetaOutboundConnectors(ctx context.Context) (*[]MetaOutboundConnectorListItemModel, error) {
+	resp, err := c.apiClient.GetMetaOutboundConnectorsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound connector metadata: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get outbound connector metadata: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetMetaOutboundConnector retrieves the property schema of an outbound provisioning connector.
+func (c *IdentityProviderClient) GetMetaOutboundConnector(ctx context.Context, connectorId string) (*MetaOutboundConnectorModel, error) {
+	resp, err := c.apiClient.GetMetaOutboundConnectorWithResponse(ctx, connectorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbound connector metadata: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get outbound connector metadata: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// ValidateOutboundConnectorProperties checks properties against the meta schema of an outbound provisioning connector.
+func ValidateOutboundConnectorProperties(meta *MetaOutboundConnectorModel, properties OutboundConnectorProperties) error {
+	if meta == nil {
+		return nil
+	}
+	_, err := checkMetaProperties("outbound connector", meta.Name, meta.Properties, properties)
+	return err
+}
+
+// MaskedProperties returns a copy of the properties with confidential values masked
+func (m OutboundConnectorConfigModel) MaskedProperties() OutboundConnectorProperties {
+	return maskProperties(m.Properties, m.confidentialKeys)
+}
+
+// String renders the configuration with confidential properties masked
+func (m OutboundConnectorConfigModel) String() string {
+	return formatConfig(m.fields(), m.MaskedProperties())
+}
+
+// GoString renders the configuration for %#v with confidential properties masked
+func (m OutboundConnectorConfigModel) GoString() string {
+	return "identity_provider.OutboundConnectorConfigModel" + m.String()
+}
+
+// MarshalJSON encodes the configuration with confidential properties masked
+func (m OutboundConnectorConfigModel) MarshalJSON() ([]byte, error) {
+	type config OutboundConnectorConfigModel
+	masked := config(m)
+	masked.Properties = m.MaskedProperties()
+	return json.Marshal(masked)
+}
+
+// LogValue renders the configuration for structured logging with confidential properties masked
+func (m OutboundConnectorConfigModel) LogValue() slog.Value {
+	return configLogValue(m.fields(), m.MaskedProperties())
+}
+
+func (m OutboundConnectorConfigModel) fields() []slog.Attr {
+	return []slog.Attr{
+		slog.String("ConnectorId", m.ConnectorId),
+		slog.String("Name", m.Name),
+		slog.Bool("IsEnabled", m.IsEnabled),
+		slog.Bool("IsDefault", m.IsDefault),
+		slog.Bool("BlockingEnabled", m.BlockingEnabled),
+		slog.Bool("RulesEnabled", m.RulesEnabled),
+	}
+}
+
+func (c *IdentityProviderClient) validateOutboundConnectorConfig(ctx context.Context, config *OutboundConnectorConfigModel) error {
+	if config == nil || config.ConnectorId == "" {
+		return fmt.Errorf("outbound connector ID is required")
+	}
+	meta, err := c.GetMetaOutboundConnector(ctx, config.ConnectorId)
+	if err != nil {
+		return err
+	}
+	keys, err := checkMetaProperties("outbound connector", meta.Name, meta.Properties, config.Properties)
+	if err != nil {
+		return err
+	}
+	config.confidentialKeys = keys
+	return nil
+}
+
+func convertToOutboundConnectorConfig(connector *internal.OutboundConnector) *OutboundConnectorConfigModel {
+	config := &OutboundConnectorConfigModel{}
+	if connector == nil {
+		config.Properties = OutboundConnectorProperties{}
+		return config
+	}
+	config.ConnectorId = connector.ConnectorId
+	config.Name = stringValue(connector.Name)
+	config.IsEnabled = connector.IsEnabled != nil && *connector.IsEnabled
+	config.IsDefault = connector.IsDefault != nil && *connector.IsDefault
+	config.BlockingEnabled = connector.BlockingEnabled != nil && *connector.BlockingEnabled
+	config.RulesEnabled = connector.RulesEnabled != nil && *connector.RulesEnabled
+	config.Properties = propertiesFromList(connector.Properties)
+	return config
+}
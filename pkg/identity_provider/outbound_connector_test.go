@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scim2ConnectorMeta = `{
+	"connectorId": "c2NpbTI", "name": "scim2", "displayName": "SCIM 2.0",
+	"properties": [
+		{"key": "scim2-user-ep", "isMandatory": true, "regex": "^https://"},
+		{"key": "scim2-username", "isMandatory": true},
+		{"key": "scim2-password", "isMandatory": true, "isConfidential": true},
+		{"key": "scim2-enable-pwd-provisioning", "type": "BOOLEAN"}
+	]
+}`
+
+func outboundConnectorRoutes(t *testing.T, updated *internal.OutboundConnectorPUTRequest) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/meta/outbound-provisioning-connectors/c2NpbTI": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, scim2ConnectorMeta)
+		},
+		"GET /api/server/v1/identity-providers/idp-id/provisioning/outbound-connectors/c2NpbTI": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"connectorId": "c2NpbTI", "name": "scim2", "isEnabled": true, "blockingEnabled": true,
+				"properties": [{"key": "scim2-username", "value": "admin"}, {"key": "scim2-password", "value": "super-secret"}]}`)
+		},
+		"PUT /api/server/v1/identity-providers/idp-id/provisioning/outbound-connectors/c2NpbTI": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(updated))
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"connectorId": "c2NpbTI", "name": "scim2", "isEnabled": *updated.IsEnabled, "properties": updated.Properties,
+			})
+		},
+	}
+}
+
+func TestGetOutboundConnector(t *testing.T) {
+	client := newRoutedTestClient(t, outboundConnectorRoutes(t, nil))
+
+	connector, err := client.GetOutboundConnector(context.Background(), "idp-id", OutboundConnectorIDs.SCIM2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "scim2", connector.Name)
+	assert.True(t, connector.IsEnabled)
+	assert.True(t, connector.BlockingEnabled)
+	assert.False(t, connector.RulesEnabled)
+	assert.Equal(t, OutboundConnectorProperties{"scim2-username": "admin", "scim2-password": "super-secret"}, connector.Properties)
+	assert.Equal(t, maskedPropertyValue, connector.MaskedProperties()["scim2-password"])
+}
+
+func TestUpdateOutboundConnector(t *testing.T) {
+	var updated internal.OutboundConnectorPUTRequest
+	client := newRoutedTestClient(t, outboundConnectorRoutes(t, &updated))
+
+	connector, err := client.UpdateOutboundConnector(context.Background(), "idp-id", &OutboundConnectorConfigModel{
+		ConnectorId: OutboundConnectorIDs.SCIM2,
+		IsEnabled:   true,
+		Properties: OutboundConnectorProperties{
+			"scim2-user-ep":  "https://scim.example.com/Users",
+			"scim2-username": "admin",
+			"scim2-password": "super-secret",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, OutboundConnectorIDs.SCIM2, *updated.ConnectorId)
+	assert.True(t, *updated.IsEnabled)
+	assert.False(t, *updated.BlockingEnabled)
+	assert.Len(t, *updated.Properties, 3)
+	assert.Equal(t, "super-secret", connector.Properties["scim2-password"])
+	assert.NotContains(t, connector.String(), "super-secret")
+}
+
+func TestUpdateOutboundConnectorRejectsInvalidProperties(t *testing.T) {
+	client := newRoutedTestClient(t, outboundConnectorRoutes(t, nil))
+
+	_, err := client.UpdateOutboundConnector(context.Background(), "idp-id", &OutboundConnectorConfigModel{
+		ConnectorId: OutboundConnectorIDs.SCIM2,
+		Properties: OutboundConnectorProperties{
+			"scim2-user-ep":                 "http://scim.example.com/Users",
+			"scim2-password":                "super-secret",
+			"scim2-enable-pwd-provisioning": "sometimes",
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid properties for outbound connector 'scim2'")
+	assert.Contains(t, err.Error(), "'scim2-username' is required")
+	assert.Contains(t, err.Error(), "'scim2-user-ep' does not match")
+	assert.Contains(t, err.Error(), "'scim2-enable-pwd-provisioning' must be a boolean")
+	assert.NotContains(t, err.Error(), "super-secret")
+
+	_, err = client.UpdateOutboundConnector(context.Background(), "idp-id", &OutboundConnectorConfigModel{})
+	assert.EqualError(t, err, "outbound connector ID is required")
+}
+
+func TestUpdateOutboundConnectorsRequiresDefault(t *testing.T) {
+	client := newRoutedTestClient(t, outboundConnectorRoutes(t, nil))
+
+	_, err := client.UpdateOutboundConnectors(context.Background(), "idp-id", OutboundConnectorIDs.SCIM, []OutboundConnectorConfigModel{
+		{
+			ConnectorId: OutboundConnectorIDs.SCIM2,
+			Properties: OutboundConnectorProperties{
+				"scim2-user-ep":  "https://scim.example.com/Users",
+				"scim2-username": "admin",
+				"scim2-password": "super-secret",
+			},
+		},
+	})
+	assert.EqualError(t, err, "default connector 'c2NpbQ' is not one of the given connectors")
+}
+
+func TestValidateOutboundConnectorProperties(t *testing.T) {
+	var meta MetaOutboundConnectorModel
+	require.NoError(t, json.Unmarshal([]byte(scim2ConnectorMeta), &meta))
+
+	require.NoError(t, ValidateOutboundConnectorProperties(nil, OutboundConnectorProperties{}))
+	require.NoError(t, ValidateOutboundConnectorProperties(&meta, OutboundConnectorProperties{
+		"scim2-user-ep":  "https://scim.example.com/Users",
+		"scim2-username": "admin",
+		"scim2-password": "super-secret",
+	}))
+	assert.ErrorContains(t, ValidateOutboundConnectorProperties(&meta, OutboundConnectorProperties{}), "'scim2-password' is required")
+}
+
+func TestOutboundConnectorConfigMasksConfidentialProperties(t *testing.T) {
+	config := OutboundConnectorConfigModel{
+		ConnectorId: OutboundConnectorIDs.SCIM2,
+		Name:        "scim2",
+		IsEnabled:   true,
+		Properties: OutboundConnectorProperties{
+			"scim2-username": "admin",
+			"scim2-password": "super-secret",
+		},
+		confidentialKeys: confidentialKeys(&[]MetaPropertyModel{{Key: "scim2-password", IsConfidential: boolPtr(true)}}),
+	}
+
+	assert.Equal(t, "{ConnectorId:c2NpbTI Name:scim2 IsEnabled:true IsDefault:false BlockingEnabled:false RulesEnabled:false "+
+		"Properties:map[scim2-password=******** scim2-username=admin]}", config.String())
+	assert.Equal(t, "identity_provider.OutboundConnectorConfigModel"+config.String(), fmt.Sprintf("%#v", config))
+
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("updating", "connector", config)
+	assert.Contains(t, logs.String(), "connector.connectorId=c2NpbTI")
+	assert.Contains(t, logs.String(), "connector.blockingEnabled=false")
+	assert.Contains(t, logs.String(), "scim2-password:"+maskedPropertyValue)
+	assert.NotContains(t, logs.String(), "super-secret")
+
+	encoded, err := json.Marshal(config)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"connectorId": "c2NpbTI", "name": "scim2", "isEnabled": true, "isDefault": false,
+		"blockingEnabled": false, "rulesEnabled": false,
+		"properties": {"scim2-username": "admin", "scim2-password": "********"}}`, string(encoded))
+}
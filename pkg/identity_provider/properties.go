@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+const maskedPropertyValue = "********"
+
+// Property keys treated as confidential when the meta schema does not mark them
+var confidentialPropertyKeyPattern = regexp.MustCompile(`(?i)secret|password|privatekey|token`)
+
+// validateMetaProperties checks properties against a meta schema. Mandatory properties without a default must be set,
+// and values must match the declared type, options and regex. Values of confidential properties are never included
+// in the returned error.
+func validateMetaProperties(kind string, name string, metaProperties *[]MetaPropertyModel, properties map[string]string) error {
+	if metaProperties == nil {
+		return nil
+	}
+
+	var problems []string
+	for _, metaProperty := range flattenMetaProperties(*metaProperties) {
+		value, exists := properties[metaProperty.Key]
+		if !exists || value == "" {
+			if metaProperty.IsMandatory != nil && *metaProperty.IsMandatory &&
+				(metaProperty.DefaultValue == nil || *metaProperty.DefaultValue == "") {
+				problems = append(problems, fmt.Sprintf("property '%s' is required", metaProperty.Key))
+			}
+			continue
+		}
+
+		shownValue := "'" + value + "'"
+		if isConfidential(metaProperty.Key, metaProperty.IsConfidential != nil && *metaProperty.IsConfidential) {
+			shownValue = "value"
+		}
+		if metaProperty.Type != nil {
+			switch *metaProperty.Type {
+			case internal.BOOLEAN:
+				if _, err := strconv.ParseBool(value); err != nil {
+					problems = append(problems, fmt.Sprintf("property '%s' must be a boolean but has %s", metaProperty.Key, shownValue))
+					continue
+				}
+			case internal.INTEGER:
+				if _, err := strconv.Atoi(value); err != nil {
+					problems = append(problems, fmt.Sprintf("property '%s' must be an integer but has %s", metaProperty.Key, shownValue))
+					continue
+				}
+			}
+		}
+		if metaProperty.Options != nil && len(*metaProperty.Options) > 0 && !containsString(*metaProperty.Options, value) {
+			problems = append(problems, fmt.Sprintf("property '%s' must be one of [%s] but has %s",
+				metaProperty.Key, strings.Join(*metaProperty.Options, ", "), shownValue))
+			continue
+		}
+		if metaProperty.Regex != nil && *metaProperty.Regex != "" {
+			pattern, err := regexp.Compile(*metaProperty.Regex)
+			if err == nil && !pattern.MatchString(value) {
+				problems = append(problems, fmt.Sprintf("property '%s' does not match the pattern '%s'", metaProperty.Key, *metaProperty.Regex))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid properties for %s '%s': %s", kind, name, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkMetaProperties validates properties against the meta properties of a federated authenticator or outbound
+// connector and returns the keys of the properties the meta schema marks as confidential
+func checkMetaProperties(kind string, name *string, metaProperties *[]MetaPropertyModel, properties map[string]string) (map[string]struct{}, error) {
+	if err := validateMetaProperties(kind, stringValue(name), metaProperties, properties); err != nil {
+		return nil, err
+	}
+	return confidentialKeys(metaProperties), nil
+}
+
+// formatConfig renders the fields of a configuration followed by its masked properties in the form
+// {Field:value ... Properties:map[key=value ...]}
+func formatConfig(fields []slog.Attr, maskedProperties map[string]string) string {
+	parts := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s:%v", field.Key, field.Value.Any()))
+	}
+	parts = append(parts, "Properties:"+formatProperties(maskedProperties))
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// configLogValue renders the fields of a configuration followed by its masked properties for structured logging,
+// using lower camel case keys
+func configLogValue(fields []slog.Attr, maskedProperties map[string]string) slog.Value {
+	attrs := make([]slog.Attr, 0, len(fields)+1)
+	for _, field := range fields {
+		attrs = append(attrs, slog.Attr{Key: strings.ToLower(field.Key[:1]) + field.Key[1:], Value: field.Value})
+	}
+	attrs = append(attrs, slog.Any("properties", maskedProperties))
+	return slog.GroupValue(attrs...)
+}
+
+// maskProperties returns a copy of the properties with confidential values masked
+func maskProperties(properties map[string]string, confidentialKeys map[string]struct{}) map[string]string {
+	masked := make(map[string]string, len(properties))
+	for key, value := range properties {
+		_, confidential := confidentialKeys[key]
+		if isConfidential(key, confidential) && value != "" {
+			value = maskedPropertyValue
+		}
+		masked[key] = value
+	}
+	return masked
+}
+
+// isConfidential reports whether the value of a property must not be shown, either because the meta schema
+// marks it confidential or because its key names a credential
+func isConfidential(key string, markedConfidential bool) bool {
+	return markedConfidential || confidentialPropertyKeyPattern.MatchString(key)
+}
+
+// formatProperties renders properties sorted by key in the form map[key=value ...]
+func formatProperties(properties map[string]string) string {
+	keys := sortedKeys(properties)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+properties[key])
+	}
+	return "map[" + strings.Join(pairs, " ") + "]"
+}
+
+func convertToProperties(properties map[string]string) *[]internal.Property {
+	keys := sortedKeys(properties)
+	result := make([]internal.Property, 0, len(keys))
+	for _, key := range keys {
+		value := properties[key]
+		result = append(result, internal.Property{Key: key, Value: &value})
+	}
+	return &result
+}
+
+func propertiesFromList(properties *[]internal.Property) map[string]string {
+	result := make(map[string]string)
+	if properties == nil {
+		return result
+	}
+	for _, property := range *properties {
+		result[property.Key] = stringValue(property.Value)
+	}
+	return result
+}
+
+func confidentialKeys(metaProperties *[]MetaPropertyModel) map[string]struct{} {
+	keys := make(map[string]struct{})
+	if metaProperties == nil {
+		return keys
+	}
+	for _, metaProperty := range flattenMetaProperties(*metaProperties) {
+		if metaProperty.IsConfidential != nil && *metaProperty.IsConfidential {
+			keys[metaProperty.Key] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// flattenMetaProperties returns the meta properties together with all their sub properties
+func flattenMetaProperties(metaProperties []MetaPropertyModel) []MetaPropertyModel {
+	var result []MetaPropertyModel
+	for _, metaProperty := range metaProperties {
+		result = append(result, metaProperty)
+		if metaProperty.SubProperties != nil {
+			result = append(result, flattenMetaProperties(*metaProperty.SubProperties)...)
+		}
+	}
+	return result
+}
+
+func sortedKeys(properties map[string]string) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,285 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/claim"
+	"github.com/asgardeo/go/pkg/role"
+)
+
+// GetJITConfig retrieves the just-in-time provisioning configuration of an identity provider.
+func (c *IdentityProviderClient) GetJITConfig(ctx context.Context, idpId string) (*JITConfigModel, error) {
+	resp, err := c.apiClient.GetJITConfigWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JIT provisioning config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get JIT provisioning config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateJITConfig updates the just-in-time provisioning configuration of an identity provider.
+func (c *IdentityProviderClient) UpdateJITConfig(ctx context.Context, idpId string, jitConfig *JITConfigModel) (*JITConfigModel, error) {
+	if jitConfig == nil {
+		return nil, fmt.Errorf("JIT provisioning config is required")
+	}
+	if jitConfig.IsEnabled && (jitConfig.Userstore == nil || *jitConfig.Userstore == "") {
+		return nil, fmt.Errorf("a user store is required to enable JIT provisioning")
+	}
+
+	resp, err := c.apiClient.UpdateJITConfigWithResponse(ctx, idpId, *jitConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update JIT provisioning config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update JIT provisioning config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// EnableJITProvisioning enables just-in-time provisioning of federated users into the given user store.
+func (c *IdentityProviderClient) EnableJITProvisioning(ctx context.Context, idpId string, userstore string, scheme JITProvisioningScheme) (*JITConfigModel, error) {
+	return c.UpdateJITConfig(ctx, idpId, &JITConfigModel{
+		IsEnabled: true,
+		Userstore: &userstore,
+		Scheme:    &scheme,
+	})
+}
+
+// GetRoleConfig retrieves the role mappings of an identity provider.
+func (c *IdentityProviderClient) GetRoleConfig(ctx context.Context, idpId string) (*RoleConfigModel, error) {
+	resp, err := c.apiClient.GetRoleConfigWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get role config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateRoleConfig updates the role mappings of an identity provider after checking that the mapped local roles exist.
+func (c *IdentityProviderClient) UpdateRoleConfig(ctx context.Context, idpId string, roleConfig *RoleConfigModel) (*RoleConfigModel, error) {
+	if roleConfig == nil {
+		return nil, fmt.Errorf("role config is required")
+	}
+	if err := c.ValidateRoleConfig(ctx, roleConfig); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.apiClient.UpdateRoleConfigWithResponse(ctx, idpId, *roleConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update role config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update role config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetGroupConfig retrieves the groups supported by an identity provider.
+func (c *IdentityProviderClient) GetGroupConfig(ctx context.Context, idpId string) (*GroupConfigModel, error) {
+	resp, err := c.apiClient.GetGroupConfigWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get group config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateGroupConfig replaces the groups supported by an identity provider. Group names must be unique.
+func (c *IdentityProviderClient) UpdateGroupConfig(ctx context.Context, idpId string, groups GroupConfigModel) (*GroupConfigModel, error) {
+	names := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if group.Name == "" {
+			return nil, fmt.Errorf("group name is required")
+		}
+		if _, exists := names[group.Name]; exists {
+			return nil, fmt.Errorf("duplicate group '%s'", group.Name)
+		}
+		names[group.Name] = struct{}{}
+	}
+
+	resp, err := c.apiClient.UpdateGroupConfigWithResponse(ctx, idpId, groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update group config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetClaimConfig retrieves the claim mappings of an identity provider.
+func (c *IdentityProviderClient) GetClaimConfig(ctx context.Context, idpId string) (*ClaimConfigModel, error) {
+	resp, err := c.apiClient.GetClaimConfigWithResponse(ctx, idpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claim config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get claim config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// UpdateClaimConfig updates the claim mappings of an identity provider after checking that the mapped local claims exist.
+func (c *IdentityProviderClient) UpdateClaimConfig(ctx context.Context, idpId string, claimConfig *ClaimConfigModel) (*ClaimConfigModel, error) {
+	if claimConfig == nil {
+		return nil, fmt.Errorf("claim config is required")
+	}
+	if err := c.ValidateClaimConfig(ctx, claimConfig); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.apiClient.UpdateClaimConfigWithResponse(ctx, idpId, *claimConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update claim config: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to update claim config: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// ValidateClaimConfig checks that every local claim URI referenced by the claim config exists in the tenant.
+func (c *IdentityProviderClient) ValidateClaimConfig(ctx context.Context, claimConfig *ClaimConfigModel) error {
+	claimURIs := localClaimURIs(claimConfig)
+	if len(claimURIs) == 0 {
+		return nil
+	}
+
+	missingClaimURIs, err := c.findMissingLocalClaims(ctx, claimURIs)
+	if err != nil {
+		return err
+	}
+	if len(missingClaimURIs) > 0 {
+		return fmt.Errorf("local claims not found: %s", strings.Join(missingClaimURIs, ", "))
+	}
+	return nil
+}
+
+// ValidateRoleConfig checks that every local role referenced by the role config exists in the tenant.
+func (c *IdentityProviderClient) ValidateRoleConfig(ctx context.Context, roleConfig *RoleConfigModel) error {
+	var roleNames []string
+	seen := make(map[string]struct{})
+	addRole := func(name string) {
+		if _, ok := seen[name]; !ok && name != "" {
+			seen[name] = struct{}{}
+			roleNames = append(roleNames, name)
+		}
+	}
+	if roleConfig.Mappings != nil {
+		for _, mapping := range *roleConfig.Mappings {
+			if mapping.IdpRole == nil || *mapping.IdpRole == "" || mapping.LocalRole == nil || *mapping.LocalRole == "" {
+				return fmt.Errorf("role mappings require both an IdP role and a local role")
+			}
+			addRole(*mapping.LocalRole)
+		}
+	}
+	if roleConfig.OutboundProvisioningRoles != nil {
+		for _, role := range *roleConfig.OutboundProvisioningRoles {
+			addRole(role)
+		}
+	}
+
+	var missingRoles []string
+	for _, roleName := range roleNames {
+		exists, err := c.roleExists(ctx, roleName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			missingRoles = append(missingRoles, roleName)
+		}
+	}
+	if len(missingRoles) > 0 {
+		return fmt.Errorf("local roles not found: %s", strings.Join(missingRoles, ", "))
+	}
+	return nil
+}
+
+// findMissingLocalClaims returns the claim URIs that are not local claims of the tenant
+func (c *IdentityProviderClient) findMissingLocalClaims(ctx context.Context, claimURIs []string) ([]string, error) {
+	claimClient, err := claim.New(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create claim client: %w", err)
+	}
+	missingClaimURIs, err := claimClient.FindMissingLocalClaims(ctx, claimURIs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local claims: %w", err)
+	}
+	return missingClaimURIs, nil
+}
+
+// localClaimURIs collects the local claim URIs referenced by a claim config
+func localClaimURIs(claimConfig *ClaimConfigModel) []string {
+	if claimConfig == nil {
+		return nil
+	}
+	var claimURIs []string
+	if claimConfig.Mappings != nil {
+		for _, mapping := range *claimConfig.Mappings {
+			if mapping.LocalClaim != nil {
+				claimURIs = append(claimURIs, mapping.LocalClaim.Uri)
+			}
+		}
+	}
+	if claimConfig.ProvisioningClaims != nil {
+		for _, provisioningClaim := range *claimConfig.ProvisioningClaims {
+			if provisioningClaim.Claim != nil {
+				claimURIs = append(claimURIs, provisioningClaim.Claim.Uri)
+			}
+		}
+	}
+	// The user ID and role claims refer to IdP claims when the IdP claims are mapped to local claims
+	if claimConfig.Mappings == nil || len(*claimConfig.Mappings) == 0 {
+		if claimConfig.UserIdClaim != nil && claimConfig.UserIdClaim.Uri != "" {
+			claimURIs = append(claimURIs, claimConfig.UserIdClaim.Uri)
+		}
+		if claimConfig.RoleClaim != nil && claimConfig.RoleClaim.Uri != "" {
+			claimURIs = append(claimURIs, claimConfig.RoleClaim.Uri)
+		}
+	}
+	return claimURIs
+}
+
+// roleExists looks up a role by name. Role names may carry the Internal domain prefix.
+func (c *IdentityProviderClient) roleExists(ctx context.Context, name string) (bool, error) {
+	displayName := name
+	if domain, roleName, found := strings.Cut(name, "/"); found && strings.EqualFold(domain, "Internal") {
+		displayName = roleName
+	}
+	roleClient, err := role.New(c.config)
+	if err != nil {
+		return false, err
+	}
+	roles, err := roleClient.FindByName(ctx, displayName)
+	if err != nil {
+		return false, err
+	}
+	return len(roles) > 0, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMappingTestClient(t *testing.T) *IdentityProviderClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/server/v1/claim-dialects/local/claims":
+			_ = json.NewEncoder(w).Encode([]map[string]string{
+				{"claimURI": "http://wso2.org/claims/emailaddress"},
+				{"claimURI": "http://wso2.org/claims/username"},
+			})
+		case "/scim2/v2/Roles":
+			resources := []map[string]string{}
+			if r.URL.Query().Get("filter") == `displayName eq "admin"` {
+				resources = append(resources, map[string]string{"displayName": "admin"})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Resources": resources})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func TestValidateClaimConfig(t *testing.T) {
+	client := newMappingTestClient(t)
+	idpClaim := "email"
+
+	require.NoError(t, client.ValidateClaimConfig(context.Background(), &ClaimConfigModel{
+		Mappings: &[]ClaimMappingModel{
+			{IdpClaim: &idpClaim, LocalClaim: &ClaimModel{Uri: "http://wso2.org/claims/emailaddress"}},
+		},
+		UserIdClaim: &ClaimModel{Uri: "email"},
+	}))
+
+	err := client.ValidateClaimConfig(context.Background(), &ClaimConfigModel{
+		ProvisioningClaims: &[]ProvisioningClaimModel{
+			{Claim: &ClaimModel{Uri: "http://wso2.org/claims/department"}},
+		},
+		UserIdClaim: &ClaimModel{Uri: "http://wso2.org/claims/username"},
+	})
+	assert.EqualError(t, err, "local claims not found: http://wso2.org/claims/department")
+}
+
+func TestValidateRoleConfig(t *testing.T) {
+	client := newMappingTestClient(t)
+	idpAdmin, idpViewer := "idp-admin", "idp-viewer"
+	admin, internalAdmin, viewer := "admin", "Internal/admin", "viewer"
+
+	require.NoError(t, client.ValidateRoleConfig(context.Background(), &RoleConfigModel{
+		Mappings: &[]RoleMappingModel{{IdpRole: &idpAdmin, LocalRole: &internalAdmin}},
+	}))
+
+	err := client.ValidateRoleConfig(context.Background(), &RoleConfigModel{
+		Mappings: &[]RoleMappingModel{
+			{IdpRole: &idpAdmin, LocalRole: &admin},
+			{IdpRole: &idpViewer, LocalRole: &viewer},
+		},
+		OutboundProvisioningRoles: &[]string{"viewer"},
+	})
+	assert.EqualError(t, err, "local roles not found: viewer")
+
+	err = client.ValidateRoleConfig(context.Background(), &RoleConfigModel{
+		Mappings: &[]RoleMappingModel{{IdpRole: &idpAdmin}},
+	})
+	assert.EqualError(t, err, "role mappings require both an IdP role and a local role")
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+const (
+	microsoftAuthorizeEndpoint = "https://login.microsoftonline.com/common/oauth2/v2.0/authorize"
+	microsoftTokenEndpoint     = "https://login.microsoftonline.com/common/oauth2/v2.0/token"
+	appleClientSecretValidity  = "15777000"
+)
+
+// socialConnection describes how a social login provider is configured
+type socialConnection struct {
+	authenticatorId string
+	defaultScopes   []string
+	scopeSeparator  string
+	properties      func(clientID string, secret string, callbackURL string, scopes string, opts *SocialConnectionOptionsModel) map[string]string
+}
+
+var (
+	googleConnection = socialConnection{
+		authenticatorId: FederatedAuthenticatorIDs.GoogleOIDC,
+		defaultScopes:   []string{"email", "openid", "profile"},
+		scopeSeparator:  " ",
+		properties: func(clientID, secret, callbackURL, scopes string, _ *SocialConnectionOptionsModel) map[string]string {
+			return map[string]string{
+				"ClientId":                  clientID,
+				"ClientSecret":              secret,
+				"callbackUrl":               callbackURL,
+				"AdditionalQueryParameters": "scope=" + scopes,
+			}
+		},
+	}
+	gitHubConnection = socialConnection{
+		authenticatorId: FederatedAuthenticatorIDs.GitHub,
+		defaultScopes:   []string{"user:email", "read:user"},
+		scopeSeparator:  " ",
+		properties: func(clientID, secret, callbackURL, scopes string, _ *SocialConnectionOptionsModel) map[string]string {
+			return map[string]string{
+				"ClientId":     clientID,
+				"ClientSecret": secret,
+				"callbackUrl":  callbackURL,
+				"scope":        scopes,
+			}
+		},
+	}
+	facebookConnection = socialConnection{
+		authenticatorId: FederatedAuthenticatorIDs.Facebook,
+		defaultScopes:   []string{"email", "public_profile"},
+		scopeSeparator:  ",",
+		properties: func(clientID, secret, callbackURL, scopes string, _ *SocialConnectionOptionsModel) map[string]string {
+			return map[string]string{
+				"ClientId":       clientID,
+				"ClientSecret":   secret,
+				"callbackUrl":    callbackURL,
+				"Scope":          scopes,
+				"UserInfoFields": "id,name,email,first_name,last_name",
+			}
+		},
+	}
+	appleConnection = socialConnection{
+		authenticatorId: FederatedAuthenticatorIDs.Apple,
+		defaultScopes:   []string{"name", "email"},
+		scopeSeparator:  " ",
+		properties: func(clientID, secret, callbackURL, scopes string, opts *SocialConnectionOptionsModel) map[string]string {
+			return map[string]string{
+				"ClientId":             clientID,
+				"PrivateKey":           secret,
+				"TeamId":               opts.AppleTeamID,
+				"KeyId":                opts.AppleKeyID,
+				"SecretValidityPeriod": appleClientSecretValidity,
+				"callbackUrl":          callbackURL,
+				"Scopes":               scopes,
+			}
+		},
+	}
+	microsoftConnection = socialConnection{
+		authenticatorId: FederatedAuthenticatorIDs.Microsoft,
+		defaultScopes:   []string{"openid", "email", "profile"},
+		scopeSeparator:  " ",
+		properties: func(clientID, secret, callbackURL, scopes string, _ *SocialConnectionOptionsModel) map[string]string {
+			return map[string]string{
+				"ClientId":         clientID,
+				"ClientSecret":     secret,
+				"OAuth2AuthzEPUrl": microsoftAuthorizeEndpoint,
+				"OAuth2TokenEPUrl": microsoftTokenEndpoint,
+				"callbackUrl":      callbackURL,
+				"Scopes":           scopes,
+			}
+		},
+	}
+)
+
+// CreateGoogleConnection creates a Google login connection.
+func (c *IdentityProviderClient) CreateGoogleConnection(ctx context.Context, name string, clientID string, secret string, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	return c.createSocialConnection(ctx, googleConnection, name, clientID, secret, opts)
+}
+
+// CreateGitHubConnection creates a GitHub login connection.
+func (c *IdentityProviderClient) CreateGitHubConnection(ctx context.Context, name string, clientID string, secret string, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	return c.createSocialConnection(ctx, gitHubConnection, name, clientID, secret, opts)
+}
+
+// CreateFacebookConnection creates a Facebook login connection.
+func (c *IdentityProviderClient) CreateFacebookConnection(ctx context.Context, name string, clientID string, secret string, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	return c.createSocialConnection(ctx, facebookConnection, name, clientID, secret, opts)
+}
+
+// CreateAppleConnection creates a Sign in with Apple connection. The secret is the private key issued by Apple,
+// and the team ID and key ID must be set in the options.
+func (c *IdentityProviderClient) CreateAppleConnection(ctx context.Context, name string, clientID string, secret string, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	if opts == nil || opts.AppleTeamID == "" || opts.AppleKeyID == "" {
+		return nil, fmt.Errorf("team ID and key ID are required for an Apple connection")
+	}
+	return c.createSocialConnection(ctx, appleConnection, name, clientID, secret, opts)
+}
+
+// CreateMicrosoftConnection creates a Microsoft login connection for personal, work and school accounts.
+func (c *IdentityProviderClient) CreateMicrosoftConnection(ctx context.Context, name string, clientID string, secret string, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	return c.createSocialConnection(ctx, microsoftConnection, name, clientID, secret, opts)
+}
+
+func (c *IdentityProviderClient) createSocialConnection(ctx context.Context, connection socialConnection, name string, clientID string, secret string,
+	opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+	if name == "" || clientID == "" || secret == "" {
+		return nil, fmt.Errorf("name, client ID and secret are required to create a connection")
+	}
+	if opts == nil {
+		opts = &SocialConnectionOptionsModel{}
+	}
+
+	scopes := connection.defaultScopes
+	if len(opts.Scopes) > 0 {
+		scopes = opts.Scopes
+	}
+
+	propertyValues := connection.properties(clientID, secret, c.callbackURL(opts.CallbackURL), strings.Join(scopes, connection.scopeSeparator), opts)
+	idp := buildConnection(name, opts.Description, connection.authenticatorId, propertyValues)
+	if opts.Image != "" {
+		idp.Image = &opts.Image
+	}
+
+	created, err := c.Create(ctx, &idp)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.AddToLoginFlow != nil {
+		if err := opts.AddToLoginFlow(ctx, name, connection.authenticatorId); err != nil {
+			return created, fmt.Errorf("created connection '%s' but failed to add it to the login flow: %w", name, err)
+		}
+	}
+	return created, nil
+}
+
+// buildConnection builds an identity provider with a single enabled federated authenticator
+func buildConnection(name string, description string, authenticatorId string, propertyValues map[string]string) IdentityProviderCreateModel {
+	keys := make([]string, 0, len(propertyValues))
+	for key := range propertyValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	properties := make([]internal.Property, 0, len(keys))
+	for _, key := range keys {
+		value := propertyValues[key]
+		properties = append(properties, internal.Property{Key: key, Value: &value})
+	}
+
+	isEnabled := true
+	isDefault := true
+	idp := IdentityProviderCreateModel{
+		Name: name,
+		FederatedAuthenticators: &internal.FederatedAuthenticatorRequest{
+			DefaultAuthenticatorId: authenticatorId,
+			Authenticators: &[]internal.FederatedAuthenticator{
+				{
+					AuthenticatorId: authenticatorId,
+					IsEnabled:       &isEnabled,
+					IsDefault:       &isDefault,
+					Properties:      &properties,
+				},
+			},
+		},
+	}
+	if description != "" {
+		idp.Description = &description
+	}
+	return idp
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// socialConnectionRoutes records the created identity provider
+func socialConnectionRoutes(t *testing.T, created *IdentityProviderCreateModel) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"POST /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			writeJSON(w, http.StatusCreated, map[string]string{"id": "idp-id", "name": created.Name})
+		},
+	}
+}
+
+func TestCreateSocialConnections(t *testing.T) {
+	appleOptions := &SocialConnectionOptionsModel{AppleTeamID: "TEAM123", AppleKeyID: "KEY123"}
+	tests := []struct {
+		name            string
+		create          func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error)
+		opts            *SocialConnectionOptionsModel
+		authenticatorId string
+		expected        map[string]string
+	}{
+		{
+			name: "Google",
+			create: func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+				return client.CreateGoogleConnection(context.Background(), "Google", "client-id", "client-secret", opts)
+			},
+			authenticatorId: FederatedAuthenticatorIDs.GoogleOIDC,
+			expected: map[string]string{
+				"ClientId":                  "client-id",
+				"ClientSecret":              "client-secret",
+				"AdditionalQueryParameters": "scope=email openid profile",
+			},
+		},
+		{
+			name: "GitHub",
+			create: func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+				return client.CreateGitHubConnection(context.Background(), "GitHub", "client-id", "client-secret", opts)
+			},
+			authenticatorId: FederatedAuthenticatorIDs.GitHub,
+			expected: map[string]string{
+				"ClientId":     "client-id",
+				"ClientSecret": "client-secret",
+				"scope":        "user:email read:user",
+			},
+		},
+		{
+			name: "Facebook",
+			create: func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+				return client.CreateFacebookConnection(context.Background(), "Facebook", "client-id", "client-secret", opts)
+			},
+			authenticatorId: FederatedAuthenticatorIDs.Facebook,
+			expected: map[string]string{
+				"ClientId":       "client-id",
+				"ClientSecret":   "client-secret",
+				"Scope":          "email,public_profile",
+				"UserInfoFields": "id,name,email,first_name,last_name",
+			},
+		},
+		{
+			name: "Apple",
+			create: func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+				return client.CreateAppleConnection(context.Background(), "Apple", "client-id", "private-key", opts)
+			},
+			opts:            appleOptions,
+			authenticatorId: FederatedAuthenticatorIDs.Apple,
+			expected: map[string]string{
+				"ClientId":             "client-id",
+				"PrivateKey":           "private-key",
+				"TeamId":               "TEAM123",
+				"KeyId":                "KEY123",
+				"SecretValidityPeriod": "15777000",
+				"Scopes":               "name email",
+			},
+		},
+		{
+			name: "Microsoft",
+			create: func(client *IdentityProviderClient, opts *SocialConnectionOptionsModel) (*IdentityProviderResponseModel, error) {
+				return client.CreateMicrosoftConnection(context.Background(), "Microsoft", "client-id", "client-secret", opts)
+			},
+			authenticatorId: FederatedAuthenticatorIDs.Microsoft,
+			expected: map[string]string{
+				"ClientId":         "client-id",
+				"ClientSecret":     "client-secret",
+				"OAuth2AuthzEPUrl": "https://login.microsoftonline.com/common/oauth2/v2.0/authorize",
+				"OAuth2TokenEPUrl": "https://login.microsoftonline.com/common/oauth2/v2.0/token",
+				"Scopes":           "openid email profile",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created IdentityProviderCreateModel
+			client := newRoutedTestClient(t, socialConnectionRoutes(t, &created))
+
+			idp, err := tt.create(client, tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, "idp-id", *idp.Id)
+
+			assert.Equal(t, tt.name, created.Name)
+			assert.Equal(t, tt.authenticatorId, created.FederatedAuthenticators.DefaultAuthenticatorId)
+			authenticator := (*created.FederatedAuthenticators.Authenticators)[0]
+			assert.Equal(t, tt.authenticatorId, authenticator.AuthenticatorId)
+			assert.True(t, *authenticator.IsEnabled)
+
+			properties := propertyMap(&created)
+			assert.Equal(t, client.config.BaseURL+"/commonauth", properties["callbackUrl"])
+			delete(properties, "callbackUrl")
+			assert.Equal(t, tt.expected, properties)
+		})
+	}
+}
+
+func TestCreateSocialConnectionWithOptions(t *testing.T) {
+	var created IdentityProviderCreateModel
+	client := newRoutedTestClient(t, socialConnectionRoutes(t, &created))
+
+	_, err := client.CreateGitHubConnection(context.Background(), "GitHub", "client-id", "client-secret", &SocialConnectionOptionsModel{
+		Description: "Developer login",
+		Image:       "https://example.com/github.png",
+		Scopes:      []string{"read:org"},
+		CallbackURL: "https://login.example.com/commonauth",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Developer login", *created.Description)
+	assert.Equal(t, "https://example.com/github.png", *created.Image)
+	properties := propertyMap(&created)
+	assert.Equal(t, "read:org", properties["scope"])
+	assert.Equal(t, "https://login.example.com/commonauth", properties["callbackUrl"])
+}
+
+func TestCreateSocialConnectionValidation(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{})
+
+	_, err := client.CreateGoogleConnection(context.Background(), "Google", "", "client-secret", nil)
+	assert.EqualError(t, err, "name, client ID and secret are required to create a connection")
+
+	_, err = client.CreateAppleConnection(context.Background(), "Apple", "client-id", "private-key", nil)
+	assert.EqualError(t, err, "team ID and key ID are required for an Apple connection")
+
+	_, err = client.CreateAppleConnection(context.Background(), "Apple", "client-id", "private-key",
+		&SocialConnectionOptionsModel{AppleTeamID: "TEAM123"})
+	assert.EqualError(t, err, "team ID and key ID are required for an Apple connection")
+}
+
+func TestCreateSocialConnectionAddsToLoginFlow(t *testing.T) {
+	var created IdentityProviderCreateModel
+	client := newRoutedTestClient(t, socialConnectionRoutes(t, &created))
+
+	var addedName, addedAuthenticatorId string
+	_, err := client.CreateGoogleConnection(context.Background(), "Google", "client-id", "client-secret", &SocialConnectionOptionsModel{
+		AddToLoginFlow: func(ctx context.Context, idpName string, authenticatorId string) error {
+			addedName, addedAuthenticatorId = idpName, authenticatorId
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Google", addedName)
+	assert.Equal(t, FederatedAuthenticatorIDs.GoogleOIDC, addedAuthenticatorId)
+}
+
+func TestCreateSocialConnectionReportsLoginFlowFailure(t *testing.T) {
+	var created IdentityProviderCreateModel
+	client := newRoutedTestClient(t, socialConnectionRoutes(t, &created))
+
+	idp, err := client.CreateGoogleConnection(context.Background(), "Google", "client-id", "client-secret", &SocialConnectionOptionsModel{
+		AddToLoginFlow: func(ctx context.Context, idpName string, authenticatorId string) error {
+			return errors.New("application not found")
+		},
+	})
+	require.Error(t, err)
+	assert.NotNil(t, idp, "the created connection is returned with the error")
+	assert.EqualError(t, err, "created connection 'Google' but failed to add it to the login flow: application not found")
+}
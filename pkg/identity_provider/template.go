@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+// GetIDPTemplates lists the identity provider templates of the tenant.
+func (c *IdentityProviderClient) GetIDPTemplates(ctx context.Context, params *IdentityProviderTemplateListParamsModel) (*IdentityProviderTemplateListResponseModel, error) {
+	resp, err := c.apiClient.GetIDPTemplatesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity provider templates: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to list identity provider templates: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetIDPTemplate retrieves an identity provider template by its ID.
+func (c *IdentityProviderClient) GetIDPTemplate(ctx context.Context, templateId string) (*IdentityProviderTemplateModel, error) {
+	resp, err := c.apiClient.GetIDPTemplateWithResponse(ctx, templateId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider template: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get identity provider template: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// AddIDPTemplate adds an identity provider template and returns the ID of the created template.
+func (c *IdentityProviderClient) AddIDPTemplate(ctx context.Context, template *IdentityProviderTemplateModel) (string, error) {
+	if template == nil || template.Name == "" {
+		return "", fmt.Errorf("template name is required")
+	}
+	resp, err := c.apiClient.AddIDPTemplateWithResponse(ctx, *template)
+	if err != nil {
+		return "", fmt.Errorf("failed to add identity provider template: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return "", fmt.Errorf("failed to add identity provider template: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+
+	// The created template is only referenced by the Location header
+	location := resp.HTTPResponse.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("failed to add identity provider template: missing location of created template")
+	}
+	return path.Base(strings.TrimSuffix(location, "/")), nil
+}
+
+// UpdateIDPTemplate replaces an identity provider template.
+func (c *IdentityProviderClient) UpdateIDPTemplate(ctx context.Context, templateId string, template *IdentityProviderTemplateModel) error {
+	if template == nil || template.Name == "" {
+		return fmt.Errorf("template name is required")
+	}
+	resp, err := c.apiClient.UpdateIDPTemplateWithResponse(ctx, templateId, *template)
+	if err != nil {
+		return fmt.Errorf("failed to update identity provider template: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to update identity provider template: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// DeleteIDPTemplate deletes an identity provider template.
+func (c *IdentityProviderClient) DeleteIDPTemplate(ctx context.Context, templateId string) error {
+	resp, err := c.apiClient.DeleteIDPTemplateWithResponse(ctx, templateId)
+	if err != nil {
+		return fmt.Errorf("failed to delete identity provider template: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete identity provider template: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+// CreateIDPFromTemplate creates an identity provider from a template. Fields set in the overrides replace those
+// of the template, except that federated authenticators are merged by ID and their properties by key, so an
+// override only needs the properties that differ, such as the client ID and secret.
+// The merged identity provider is validated against the federated authenticator schemas before it is created.
+func (c *IdentityProviderClient) CreateIDPFromTemplate(ctx context.Context, templateId string, overrides *IdentityProviderCreateModel) (*IdentityProviderResponseModel, error) {
+	template, err := c.GetIDPTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	idp, err := mergeIdentityProviderRequest(template.Idp, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply overrides to template '%s': %w", template.Name, err)
+	}
+	idp.TemplateId = &templateId
+
+	if err := c.validateIdentityProviderRequest(ctx, idp); err != nil {
+		return nil, err
+	}
+	return c.Create(ctx, idp)
+}
+
+// validateIdentityProviderRequest checks that an identity provider is complete and that its federated
+// authenticators match their schemas
+func (c *IdentityProviderClient) validateIdentityProviderRequest(ctx context.Context, idp *IdentityProviderCreateModel) error {
+	if idp.Name == "" {
+		return fmt.Errorf("identity provider name is required")
+	}
+	if idp.Certificate != nil && idp.Certificate.JwksUri != nil && *idp.Certificate.JwksUri != "" {
+		if err := requireHTTPS(*idp.Certificate.JwksUri, "JWKS URI"); err != nil {
+			return err
+		}
+	}
+	if idp.FederatedAuthenticators == nil || idp.FederatedAuthenticators.Authenticators == nil {
+		return nil
+	}
+
+	hasDefault := false
+	for _, authenticator := range *idp.FederatedAuthenticators.Authenticators {
+		hasDefault = hasDefault || authenticator.AuthenticatorId == idp.FederatedAuthenticators.DefaultAuthenticatorId
+		if authenticator.IsEnabled != nil && !*authenticator.IsEnabled {
+			continue
+		}
+		meta, err := c.GetMetaFederatedAuthenticator(ctx, authenticator.AuthenticatorId)
+		if err != nil {
+			return err
+		}
+		if err := ValidateFederatedAuthenticatorProperties(meta, propertiesFromList(authenticator.Properties)); err != nil {
+			return err
+		}
+	}
+	if !hasDefault {
+		return fmt.Errorf("default authenticator '%s' is not one of the federated authenticators of '%s'",
+			idp.FederatedAuthenticators.DefaultAuthenticatorId, idp.Name)
+	}
+	return nil
+}
+
+// mergeIdentityProviderRequest applies overrides to the identity provider of a template
+func mergeIdentityProviderRequest(base internal.IdentityProviderPOSTRequest, overrides *IdentityProviderCreateModel) (*IdentityProviderCreateModel, error) {
+	merged := map[string]interface{}{}
+	if err := convertJSON(base, &merged); err != nil {
+		return nil, err
+	}
+	if overrides != nil {
+		overrideFields := map[string]interface{}{}
+		if err := convertJSON(overrides, &overrideFields); err != nil {
+			return nil, err
+		}
+		if overrides.Name == "" {
+			delete(overrideFields, "name")
+		}
+		// Federated authenticators are merged separately below
+		delete(overrideFields, "federatedAuthenticators")
+		mergeJSONObjects(merged, overrideFields)
+	}
+
+	idp := &IdentityProviderCreateModel{}
+	if err := convertJSON(merged, idp); err != nil {
+		return nil, err
+	}
+	if overrides != nil && overrides.FederatedAuthenticators != nil {
+		idp.FederatedAuthenticators = mergeFederatedAuthenticators(base.FederatedAuthenticators, overrides.FederatedAuthenticators)
+	}
+	return idp, nil
+}
+
+// mergeFederatedAuthenticators merges authenticators by ID and their properties by key
+func mergeFederatedAuthenticators(base *internal.FederatedAuthenticatorRequest, overrides *internal.FederatedAuthenticatorRequest) *internal.FederatedAuthenticatorRequest {
+	if base == nil {
+		return overrides
+	}
+
+	merged := &internal.FederatedAuthenticatorRequest{DefaultAuthenticatorId: base.DefaultAuthenticatorId}
+	if overrides.DefaultAuthenticatorId != "" {
+		merged.DefaultAuthenticatorId = overrides.DefaultAuthenticatorId
+	}
+
+	var authenticators []internal.FederatedAuthenticator
+	indexById := map[string]int{}
+	if base.Authenticators != nil {
+		for _, authenticator := range *base.Authenticators {
+			indexById[authenticator.AuthenticatorId] = len(authenticators)
+			authenticators = append(authenticators, authenticator)
+		}
+	}
+	if overrides.Authenticators != nil {
+		for _, override := range *overrides.Authenticators {
+			i, exists := indexById[override.AuthenticatorId]
+			if !exists {
+				indexById[override.AuthenticatorId] = len(authenticators)
+				authenticators = append(authenticators, override)
+				continue
+			}
+			authenticator := authenticators[i]
+			if override.IsEnabled != nil {
+				authenticator.IsEnabled = override.IsEnabled
+			}
+			if override.IsDefault != nil {
+				authenticator.IsDefault = override.IsDefault
+			}
+			if override.Name != nil {
+				authenticator.Name = override.Name
+			}
+			if override.Properties != nil {
+				properties := propertiesFromList(authenticator.Properties)
+				for key, value := range propertiesFromList(override.Properties) {
+					properties[key] = value
+				}
+				authenticator.Properties = convertToProperties(properties)
+			}
+			authenticators[i] = authenticator
+		}
+	}
+	merged.Authenticators = &authenticators
+	return merged
+}
+
+// mergeJSONObjects recursively merges the override object into the base object. Arrays and values are replaced.
+func mergeJSONObjects(base map[string]interface{}, overrides map[string]interface{}) {
+	for key, override := range overrides {
+		overrideObject, overrideIsObject := override.(map[string]interface{})
+		baseObject, baseIsObject := base[key].(map[string]interface{})
+		if overrideIsObject && baseIsObject {
+			mergeJSONObjects(baseObject, overrideObject)
+			continue
+		}
+		base[key] = override
+	}
+}
+
+func convertJSON(source interface{}, target interface{}) error {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeIdentityProviderRequest(t *testing.T) {
+	description := "Company Keycloak"
+	image := "https://example.com/keycloak.png"
+	template := buildConnection("Keycloak", description, FederatedAuthenticatorIDs.OIDC, map[string]string{
+		"ClientId":         "placeholder",
+		"OAuth2AuthzEPUrl": "https://keycloak.example.com/auth",
+		"OAuth2TokenEPUrl": "https://keycloak.example.com/token",
+	})
+	template.Image = &image
+	template.Claims = &ClaimConfigModel{UserIdClaim: &ClaimModel{Uri: "sub"}, RoleClaim: &ClaimModel{Uri: "roles"}}
+
+	overrideDescription := "Team Keycloak"
+	override := buildConnection("Team Keycloak", overrideDescription, FederatedAuthenticatorIDs.OIDC, map[string]string{
+		"ClientId":     "team-client",
+		"ClientSecret": "team-secret",
+	})
+	override.Claims = &ClaimConfigModel{UserIdClaim: &ClaimModel{Uri: "email"}}
+
+	merged, err := mergeIdentityProviderRequest(template, &override)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Team Keycloak", merged.Name)
+	assert.Equal(t, overrideDescription, *merged.Description)
+	assert.Equal(t, image, *merged.Image)
+	assert.Equal(t, "email", merged.Claims.UserIdClaim.Uri)
+	assert.Equal(t, "roles", merged.Claims.RoleClaim.Uri)
+
+	require.Len(t, *merged.FederatedAuthenticators.Authenticators, 1)
+	assert.Equal(t, map[string]string{
+		"ClientId":         "team-client",
+		"ClientSecret":     "team-secret",
+		"OAuth2AuthzEPUrl": "https://keycloak.example.com/auth",
+		"OAuth2TokenEPUrl": "https://keycloak.example.com/token",
+	}, propertiesFromList((*merged.FederatedAuthenticators.Authenticators)[0].Properties))
+
+	// The template itself is left untouched
+	assert.Equal(t, "placeholder", propertiesFromList((*template.FederatedAuthenticators.Authenticators)[0].Properties)["ClientId"])
+}
+
+func TestMergeIdentityProviderRequestAddsAuthenticators(t *testing.T) {
+	template := buildConnection("Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{"ClientId": "client"})
+
+	merged, err := mergeIdentityProviderRequest(template, &IdentityProviderCreateModel{
+		FederatedAuthenticators: &internal.FederatedAuthenticatorRequest{
+			DefaultAuthenticatorId: FederatedAuthenticatorIDs.SAML,
+			Authenticators: &[]internal.FederatedAuthenticator{
+				{AuthenticatorId: FederatedAuthenticatorIDs.SAML},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Keycloak", merged.Name)
+	assert.Equal(t, FederatedAuthenticatorIDs.SAML, merged.FederatedAuthenticators.DefaultAuthenticatorId)
+	require.Len(t, *merged.FederatedAuthenticators.Authenticators, 2)
+	assert.Equal(t, FederatedAuthenticatorIDs.OIDC, (*merged.FederatedAuthenticators.Authenticators)[0].AuthenticatorId)
+	assert.Equal(t, FederatedAuthenticatorIDs.SAML, (*merged.FederatedAuthenticators.Authenticators)[1].AuthenticatorId)
+}
+
+const oidcAuthenticatorMeta = `{
+	"authenticatorId": "T3BlbklEQ29ubmVjdEF1dGhlbnRpY2F0b3I",
+	"name": "OpenIDConnectAuthenticator",
+	"properties": [
+		{"key": "ClientId", "isMandatory": true},
+		{"key": "ClientSecret", "isMandatory": true, "isConfidential": true},
+		{"key": "OAuth2AuthzEPUrl", "isMandatory": true}
+	]
+}`
+
+// templateRoutes serves an OIDC template with its authenticator schema and records the created identity provider
+func templateRoutes(t *testing.T, created *IdentityProviderCreateModel) map[string]http.HandlerFunc {
+	template := buildConnection("Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{
+		"ClientId":         "placeholder",
+		"OAuth2AuthzEPUrl": "https://keycloak.example.com/auth",
+	})
+	return map[string]http.HandlerFunc{
+		"GET /api/server/v1/identity-providers/templates/template-id": func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": "template-id", "name": "Keycloak", "idp": template})
+		},
+		"GET /api/server/v1/identity-providers/meta/federated-authenticators/" + FederatedAuthenticatorIDs.OIDC: func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, oidcAuthenticatorMeta)
+		},
+		"POST /api/server/v1/identity-providers": func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			writeJSON(w, http.StatusCreated, `{"id": "idp-id", "name": "Team Keycloak"}`)
+		},
+	}
+}
+
+func TestCreateIDPFromTemplate(t *testing.T) {
+	var created IdentityProviderCreateModel
+	client := newRoutedTestClient(t, templateRoutes(t, &created))
+
+	override := buildConnection("Team Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{
+		"ClientId":     "team-client",
+		"ClientSecret": "team-secret",
+	})
+	idp, err := client.CreateIDPFromTemplate(context.Background(), "template-id", &override)
+	require.NoError(t, err)
+	assert.Equal(t, "idp-id", *idp.Id)
+
+	assert.Equal(t, "Team Keycloak", created.Name)
+	assert.Equal(t, "template-id", *created.TemplateId)
+	require.NotNil(t, created.FederatedAuthenticators)
+	assert.Equal(t, FederatedAuthenticatorIDs.OIDC, created.FederatedAuthenticators.DefaultAuthenticatorId)
+	require.Len(t, *created.FederatedAuthenticators.Authenticators, 1)
+	defaultAuthenticator := (*created.FederatedAuthenticators.Authenticators)[0]
+	assert.Equal(t, FederatedAuthenticatorIDs.OIDC, defaultAuthenticator.AuthenticatorId)
+	assert.Equal(t, map[string]string{
+		"ClientId":         "team-client",
+		"ClientSecret":     "team-secret",
+		"OAuth2AuthzEPUrl": "https://keycloak.example.com/auth",
+	}, propertiesFromList(defaultAuthenticator.Properties))
+}
+
+func TestCreateIDPFromTemplateRequiresMandatoryProperties(t *testing.T) {
+	routes := templateRoutes(t, nil)
+	delete(routes, "POST /api/server/v1/identity-providers")
+	client := newRoutedTestClient(t, routes)
+
+	override := buildConnection("Team Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{"ClientId": "team-client"})
+	_, err := client.CreateIDPFromTemplate(context.Background(), "template-id", &override)
+	assert.EqualError(t, err, "invalid properties for federated authenticator 'OpenIDConnectAuthenticator': property 'ClientSecret' is required")
+}
+
+func TestCreateIDPFromTemplateRequiresDefaultAuthenticator(t *testing.T) {
+	routes := templateRoutes(t, nil)
+	delete(routes, "POST /api/server/v1/identity-providers")
+	client := newRoutedTestClient(t, routes)
+
+	override := buildConnection("Team Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{"ClientSecret": "team-secret"})
+	override.FederatedAuthenticators.DefaultAuthenticatorId = FederatedAuthenticatorIDs.SAML
+	_, err := client.CreateIDPFromTemplate(context.Background(), "template-id", &override)
+	assert.EqualError(t, err, "default authenticator '"+FederatedAuthenticatorIDs.SAML+
+		"' is not one of the federated authenticators of 'Team Keycloak'")
+}
+
+func TestAddIDPTemplate(t *testing.T) {
+	var added IdentityProviderTemplateModel
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"POST /api/server/v1/identity-providers/templates": func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&added))
+			w.Header().Set("Location", "https://api.asgardeo.io/t/example/api/server/v1/identity-providers/templates/template-id")
+			w.WriteHeader(http.StatusCreated)
+		},
+	})
+
+	templateId, err := client.AddIDPTemplate(context.Background(), &IdentityProviderTemplateModel{
+		Name: "Keycloak",
+		Idp:  buildConnection("Keycloak", "", FederatedAuthenticatorIDs.OIDC, map[string]string{"ClientId": "placeholder"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "template-id", templateId)
+	assert.Equal(t, "Keycloak", added.Name)
+}
+
+func TestAddIDPTemplateRequiresLocation(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"POST /api/server/v1/identity-providers/templates": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		},
+	})
+
+	_, err := client.AddIDPTemplate(context.Background(), &IdentityProviderTemplateModel{Name: "Keycloak"})
+	assert.EqualError(t, err, "failed to add identity provider template: missing location of created template")
+}
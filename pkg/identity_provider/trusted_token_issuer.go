@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/common"
+	"github.com/asgardeo/go/pkg/identity_provider/internal"
+)
+
+// AddTrustedTokenIssuer registers an issuer whose tokens can be exchanged for Asgardeo tokens.
+// Either a JWKS URI or certificates must be set to verify the issuer's tokens. The alias defaults to the
+// token endpoint of the tenant.
+func (c *IdentityProviderClient) AddTrustedTokenIssuer(ctx context.Context, issuer *TrustedTokenIssuerCreateModel) (*TrustedTokenIssuerResponseModel, error) {
+	if issuer == nil || issuer.Name == "" || issuer.Issuer == "" {
+		return nil, fmt.Errorf("name and issuer are required to add a trusted token issuer")
+	}
+	hasJWKS := issuer.Certificate.JwksUri != nil && *issuer.Certificate.JwksUri != ""
+	hasCertificates := issuer.Certificate.Certificates != nil && len(*issuer.Certificate.Certificates) > 0
+	if hasJWKS == hasCertificates {
+		return nil, fmt.Errorf("exactly one of a JWKS URI or certificates is required to add a trusted token issuer")
+	}
+	if hasJWKS {
+		if err := requireHTTPS(*issuer.Certificate.JwksUri, "JWKS URI"); err != nil {
+			return nil, err
+		}
+	}
+
+	request := *issuer
+	if request.Alias == nil || *request.Alias == "" {
+		// Subject tokens refer to Asgardeo by its token endpoint unless another audience is agreed with the issuer
+		alias := c.tokenEndpoint()
+		request.Alias = &alias
+	}
+
+	resp, err := c.apiClient.AddTrustedTokenIssuerWithResponse(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add trusted token issuer: %w", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return nil, fmt.Errorf("failed to add trusted token issuer: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON201, nil
+}
+
+// AddTrustedTokenIssuerFromJWKS registers a trusted token issuer whose tokens are verified with keys from a JWKS URI.
+func (c *IdentityProviderClient) AddTrustedTokenIssuerFromJWKS(ctx context.Context, name string, issuer string, jwksURI string,
+	opts *TrustedTokenIssuerOptionsModel) (*TrustedTokenIssuerResponseModel, error) {
+	return c.AddTrustedTokenIssuer(ctx, buildTrustedTokenIssuer(name, issuer, CertificateModel{JwksUri: &jwksURI}, opts))
+}
+
+// AddTrustedTokenIssuerFromCertificate registers a trusted token issuer whose tokens are verified with
+// a PEM encoded certificate.
+func (c *IdentityProviderClient) AddTrustedTokenIssuerFromCertificate(ctx context.Context, name string, issuer string, certificatePEM string,
+	opts *TrustedTokenIssuerOptionsModel) (*TrustedTokenIssuerResponseModel, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate of trusted token issuer '%s' is not PEM encoded", name)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("invalid certificate for trusted token issuer '%s': %w", name, err)
+	}
+	certificates := []string{base64.StdEncoding.EncodeToString(pem.EncodeToMemory(block))}
+	return c.AddTrustedTokenIssuer(ctx, buildTrustedTokenIssuer(name, issuer, CertificateModel{Certificates: &certificates}, opts))
+}
+
+// GetTrustedTokenIssuer retrieves a trusted token issuer by its ID.
+func (c *IdentityProviderClient) GetTrustedTokenIssuer(ctx context.Context, id string) (*TrustedTokenIssuerResponseModel, error) {
+	resp, err := c.apiClient.GetTrustedTokenIssuerWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trusted token issuer: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to get trusted token issuer: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetTrustedTokenIssuers lists the trusted token issuers of the tenant.
+func (c *IdentityProviderClient) GetTrustedTokenIssuers(ctx context.Context, params *TrustedTokenIssuerListParamsModel) (*IdentityProviderListResponseModel, error) {
+	resp, err := c.apiClient.GetTrustedTokenIssuersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trusted token issuers: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to list trusted token issuers: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// GetTrustedTokenIssuerByName finds a trusted token issuer by its exact name.
+func (c *IdentityProviderClient) GetTrustedTokenIssuerByName(ctx context.Context, name string) (*IdentityProviderListItemModel, error) {
+	filter := common.Eq("name", name).String()
+	issuers, err := c.GetTrustedTokenIssuers(ctx, &TrustedTokenIssuerListParamsModel{Filter: &filter})
+	if err != nil {
+		return nil, err
+	}
+	if issuers != nil && issuers.IdentityProviders != nil {
+		for _, issuer := range *issuers.IdentityProviders {
+			if issuer.Name != nil && *issuer.Name == name {
+				return &issuer, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no trusted token issuer found with name: %s", name)
+}
+
+// PatchTrustedTokenIssuer applies patch operations to the root level attributes of a trusted token issuer,
+// such as its issuer and alias values.
+func (c *IdentityProviderClient) PatchTrustedTokenIssuer(ctx context.Context, id string, operations []IdentityProviderPatchModel) (*TrustedTokenIssuerResponseModel, error) {
+	resp, err := c.apiClient.PatchTrustedTokenIssuerWithResponse(ctx, id, operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch trusted token issuer: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("failed to patch trusted token issuer: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// SetTrustedTokenIssuerValues replaces the issuer and alias values of a trusted token issuer.
+// Empty values are left unchanged.
+func (c *IdentityProviderClient) SetTrustedTokenIssuerValues(ctx context.Context, id string, issuer string, alias string) (*TrustedTokenIssuerResponseModel, error) {
+	var operations []IdentityProviderPatchModel
+	if issuer != "" {
+		operations = append(operations, IdentityProviderPatchModel{Operation: PatchOperationReplace, Path: "/issuer", Value: &issuer})
+	}
+	if alias != "" {
+		operations = append(operations, IdentityProviderPatchModel{Operation: PatchOperationReplace, Path: "/alias", Value: &alias})
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("an issuer or alias value is required")
+	}
+	return c.PatchTrustedTokenIssuer(ctx, id, operations)
+}
+
+// DeleteTrustedTokenIssuer deletes a trusted token issuer.
+func (c *IdentityProviderClient) DeleteTrustedTokenIssuer(ctx context.Context, id string, opts *IdentityProviderDeleteOptionsModel) error {
+	params := internal.DeleteTrustedTokenIssuerParams{}
+	if opts != nil && opts.Force {
+		params.Force = &opts.Force
+	}
+	resp, err := c.apiClient.DeleteTrustedTokenIssuerWithResponse(ctx, id, &params)
+	if err != nil {
+		return fmt.Errorf("failed to delete trusted token issuer: %w", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("failed to delete trusted token issuer: status %d, body: %s", resp.StatusCode(), string(resp.Body))
+	}
+	return nil
+}
+
+func (c *IdentityProviderClient) tokenEndpoint() string {
+	return strings.TrimSuffix(c.config.BaseURL, "/") + "/oauth2/token"
+}
+
+func buildTrustedTokenIssuer(name string, issuer string, certificate CertificateModel, opts *TrustedTokenIssuerOptionsModel) *TrustedTokenIssuerCreateModel {
+	trustedTokenIssuer := &TrustedTokenIssuerCreateModel{
+		Name:        name,
+		Issuer:      issuer,
+		Certificate: certificate,
+	}
+	if opts == nil {
+		return trustedTokenIssuer
+	}
+	if opts.Description != "" {
+		trustedTokenIssuer.Description = &opts.Description
+	}
+	if opts.Image != "" {
+		trustedTokenIssuer.Image = &opts.Image
+	}
+	if opts.Alias != "" {
+		trustedTokenIssuer.Alias = &opts.Alias
+	}
+	return trustedTokenIssuer
+}
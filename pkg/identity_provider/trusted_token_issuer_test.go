@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package identity_provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trustedTokenIssuerRoutes(t *testing.T, created *TrustedTokenIssuerCreateModel) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"POST /api/server/v1/trusted-token-issuers": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"id": "issuer-id", "name": created.Name, "alias": created.Alias})
+		},
+	}
+}
+
+func TestAddTrustedTokenIssuerFromJWKS(t *testing.T) {
+	var created TrustedTokenIssuerCreateModel
+	client := newRoutedTestClient(t, trustedTokenIssuerRoutes(t, &created))
+
+	issuer, err := client.AddTrustedTokenIssuerFromJWKS(context.Background(), "Partner", "https://partner.example.com",
+		"https://partner.example.com/jwks", &TrustedTokenIssuerOptionsModel{Description: "Partner tokens"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "issuer-id", *issuer.Id)
+	assert.Equal(t, "Partner", created.Name)
+	assert.Equal(t, "https://partner.example.com", created.Issuer)
+	assert.Equal(t, "Partner tokens", *created.Description)
+	assert.Equal(t, "https://partner.example.com/jwks", *created.Certificate.JwksUri)
+	assert.Nil(t, created.Certificate.Certificates)
+	assert.Equal(t, client.config.BaseURL+"/oauth2/token", *created.Alias, "alias must default to the token endpoint")
+}
+
+func TestAddTrustedTokenIssuerKeepsAlias(t *testing.T) {
+	var created TrustedTokenIssuerCreateModel
+	client := newRoutedTestClient(t, trustedTokenIssuerRoutes(t, &created))
+
+	_, err := client.AddTrustedTokenIssuerFromJWKS(context.Background(), "Partner", "https://partner.example.com",
+		"https://partner.example.com/jwks", &TrustedTokenIssuerOptionsModel{Alias: "asgardeo"})
+	require.NoError(t, err)
+	assert.Equal(t, "asgardeo", *created.Alias)
+}
+
+func TestAddTrustedTokenIssuerFromCertificate(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer tlsServer.Close()
+	certDER := tlsServer.Certificate().Raw
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+
+	var created TrustedTokenIssuerCreateModel
+	client := newRoutedTestClient(t, trustedTokenIssuerRoutes(t, &created))
+
+	_, err := client.AddTrustedTokenIssuerFromCertificate(context.Background(), "Partner", "https://partner.example.com", string(certPEM), nil)
+	require.NoError(t, err)
+
+	assert.Nil(t, created.Certificate.JwksUri)
+	require.Len(t, *created.Certificate.Certificates, 1)
+	decoded, err := base64.StdEncoding.DecodeString((*created.Certificate.Certificates)[0])
+	require.NoError(t, err)
+	block, _ := pem.Decode(decoded)
+	require.NotNil(t, block)
+	assert.Equal(t, certDER, block.Bytes)
+}
+
+func TestAddTrustedTokenIssuerValidation(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{})
+	ctx := context.Background()
+	jwksURI := "https://partner.example.com/jwks"
+	certificates := []string{"cert"}
+
+	_, err := client.AddTrustedTokenIssuer(ctx, &TrustedTokenIssuerCreateModel{Issuer: "https://partner.example.com"})
+	assert.EqualError(t, err, "name and issuer are required to add a trusted token issuer")
+
+	_, err = client.AddTrustedTokenIssuer(ctx, &TrustedTokenIssuerCreateModel{Name: "Partner", Issuer: "https://partner.example.com"})
+	assert.EqualError(t, err, "exactly one of a JWKS URI or certificates is required to add a trusted token issuer")
+
+	_, err = client.AddTrustedTokenIssuer(ctx, &TrustedTokenIssuerCreateModel{Name: "Partner", Issuer: "https://partner.example.com",
+		Certificate: CertificateModel{JwksUri: &jwksURI, Certificates: &certificates}})
+	assert.EqualError(t, err, "exactly one of a JWKS URI or certificates is required to add a trusted token issuer")
+
+	_, err = client.AddTrustedTokenIssuerFromJWKS(ctx, "Partner", "https://partner.example.com", "http://partner.example.com/jwks", nil)
+	assert.ErrorContains(t, err, "JWKS URI")
+
+	_, err = client.AddTrustedTokenIssuerFromCertificate(ctx, "Partner", "https://partner.example.com", "not a certificate", nil)
+	assert.EqualError(t, err, "certificate of trusted token issuer 'Partner' is not PEM encoded")
+}
+
+func TestGetTrustedTokenIssuerByName(t *testing.T) {
+	client := newRoutedTestClient(t, map[string]http.HandlerFunc{
+		"GET /api/server/v1/trusted-token-issuers": func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.URL.Query().Get("filter"), "name eq ")
+			writeJSON(w, http.StatusOK, `{"totalResults": 2, "identityProviders": [
+				{"id": "other-id", "name": "Partner Staging"},
+				{"id": "issuer-id", "name": "Partner", "isEnabled": true}
+			]}`)
+		},
+	})
+
+	issuer, err := client.GetTrustedTokenIssuerByName(context.Background(), "Partner")
+	require.NoError(t, err)
+	assert.Equal(t, "issuer-id", *issuer.Id)
+
+	_, err = client.GetTrustedTokenIssuerByName(context.Background(), "Unknown")
+	assert.EqualError(t, err, "no trusted token issuer found with name: Unknown")
+}
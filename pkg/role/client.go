@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/asgardeo/go/pkg/common"
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/asgardeo/go/pkg/role/internal"
+)
+
+type RoleClient struct {
+	config    *config.ClientConfig
+	apiClient *internal.Client
+}
+
+func New(cfg *config.ClientConfig) (*RoleClient, error) {
+	authEditorFn := common.CreateAuthRequestEditorFunc(cfg)
+
+	typedAuthEditorFn := func(ctx context.Context, req *http.Request) error {
+		editorFn := authEditorFn.(func(context.Context, *http.Request) error)
+		return editorFn(ctx, req)
+	}
+
+	apiClient, err := internal.NewClient(
+		cfg.BaseURL+"/scim2",
+		internal.WithHTTPClient(cfg.HTTPClient),
+		internal.WithRequestEditorFn(typedAuthEditorFn),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role client: %w", err)
+	}
+
+	return &RoleClient{
+		config:    cfg,
+		apiClient: apiClient,
+	}, nil
+}
+
+// FindByName returns the roles with the given display name.
+// Roles of different audiences may share a name, so more than one role can be returned.
+func (c *RoleClient) FindByName(ctx context.Context, displayName string) ([]RoleModel, error) {
+	filter := common.Eq("displayName", displayName).String()
+	resp, err := c.apiClient.ListRoles(ctx, &internal.ListRolesParams{Filter: &filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up role '%s': %w", displayName, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up role '%s': %w", displayName, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to look up role '%s': status %d, body: %s", displayName, resp.StatusCode, string(body))
+	}
+
+	var roleList internal.RoleListResponse
+	if err := json.Unmarshal(body, &roleList); err != nil {
+		return nil, fmt.Errorf("failed to parse role lookup response: %w", err)
+	}
+	roles := make([]RoleModel, 0, len(roleList.Resources))
+	for _, role := range roleList.Resources {
+		// The filter is case-insensitive on some servers, so only exact matches are returned
+		if role.DisplayName == displayName {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+// FindOrganizationRole returns the organization audience role with the given name.
+// Returns nil if no such role exists.
+func (c *RoleClient) FindOrganizationRole(ctx context.Context, displayName string) (*RoleModel, error) {
+	roles, err := c.FindByName(ctx, displayName)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Audience != nil && strings.EqualFold(role.Audience.Type, RoleAudienceTypes.Organization) {
+			return &role, nil
+		}
+	}
+	return nil, nil
+}
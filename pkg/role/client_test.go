@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *RoleClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
+func TestFindByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/scim2/v2/Roles", r.URL.Path)
+		assert.Equal(t, `displayName eq "Manager"`, r.URL.Query().Get("filter"))
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/scim+json")
+		_, _ = io.WriteString(w, `{"totalResults": 3, "Resources": [
+			{"id": "app-manager", "displayName": "Manager", "audience": {"value": "app-id", "type": "application"}},
+			{"id": "org-manager", "displayName": "Manager", "audience": {"value": "org-id", "type": "organization"}},
+			{"id": "other", "displayName": "manager", "audience": {"value": "org-id", "type": "organization"}}
+		]}`)
+	})
+
+	roles, err := client.FindByName(context.Background(), "Manager")
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, "app-manager", roles[0].Id)
+
+	role, err := client.FindOrganizationRole(context.Background(), "Manager")
+	require.NoError(t, err)
+	require.NotNil(t, role)
+	assert.Equal(t, "org-manager", role.Id)
+}
+
+func TestFindOrganizationRoleNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"totalResults": 0, "Resources": []}`)
+	})
+
+	role, err := client.FindOrganizationRole(context.Background(), "Manager")
+	require.NoError(t, err)
+	assert.Nil(t, role)
+}
+
+func TestFindByNameReportsFailure(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `{"detail": "insufficient scope"}`)
+	})
+
+	_, err := client.FindByName(context.Background(), "Manager")
+	assert.EqualError(t, err, `failed to look up role 'Manager': status 403, body: {"detail": "insufficient scope"}`)
+}
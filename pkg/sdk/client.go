@@ -19,6 +19,8 @@
 package sdk
 
 import (
+	"context"
+
 	"github.com/asgardeo/go/pkg/api_resource"
 	"github.com/asgardeo/go/pkg/application"
 	"github.com/asgardeo/go/pkg/authenticator"
@@ -26,6 +28,7 @@ import (
 	"github.com/asgardeo/go/pkg/config"
 	"github.com/asgardeo/go/pkg/identity_provider"
 	"github.com/asgardeo/go/pkg/oidc_scope"
+	"github.com/asgardeo/go/pkg/role"
 	"github.com/asgardeo/go/pkg/user"
 )
 
@@ -38,6 +41,7 @@ type Client struct {
 	Authenticator    *authenticator.AuthenticatorClient
 	Claim            *claim.ClaimClient
 	User             *user.UserClient
+	Role             *role.RoleClient
 	OIDCScopeClient  *oidc_scope.OIDCScopeClient
 }
 
@@ -74,6 +78,11 @@ func New(cfg *config.ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
+	roleClient, err := role.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	oidcScopeClient, err := oidc_scope.New(cfg)
 	if err != nil {
 		return nil, err
@@ -87,6 +96,26 @@ func New(cfg *config.ClientConfig) (*Client, error) {
 		Authenticator:    authenticatorClient,
 		Claim:            claimClient,
 		User:             userClient,
+		Role:             roleClient,
 		OIDCScopeClient:  oidcScopeClient,
 	}, nil
 }
+
+// CloneApplication clones an application of this tenant into the tenant of the target client.
+// The application is cloned within this tenant when target is nil.
+func (c *Client) CloneApplication(ctx context.Context, sourceId string, newName string, target *Client, overrides *application.ApplicationCloneOverridesModel) (*application.ApplicationCloneResultModel, error) {
+	cloneOverrides := application.ApplicationCloneOverridesModel{}
+	if overrides != nil {
+		cloneOverrides = *overrides
+	}
+	if target != nil {
+		cloneOverrides.TargetConfig = target.Config
+	}
+	return c.Application.Clone(ctx, sourceId, newName, &cloneOverrides)
+}
+
+// ReplaceAPIResourceScopes reconciles the scopes of an API resource with the given scopes and warns about
+// removed scopes that are still authorized to applications of this tenant.
+func (c *Client) ReplaceAPIResourceScopes(ctx context.Context, id string, scopes []api_resource.ScopeCreateModel) (*api_resource.ScopeReconciliationResultModel, error) {
+	return c.APIResource.ReplaceScopes(ctx, id, scopes, c.Application.GetAuthorizedScopeNames)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asgardeo/go/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSDKClient(t *testing.T, routes map[string]string) (*Client, map[string]int) {
+	t.Helper()
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		requests[key]++
+		body, ok := routes[key]
+		if !ok {
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", "/api/server/v1/applications/cloned-app")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(config.DefaultClientConfig().WithBaseURL(server.URL).WithHTTPClient(server.Client()).WithToken("test-token"))
+	require.NoError(t, err)
+	return client, requests
+}
+
+func TestCloneApplicationIntoTargetTenant(t *testing.T) {
+	source, sourceRequests := newTestSDKClient(t, map[string]string{
+		"GET /api/server/v1/applications/src-app":                        `{"id": "src-app", "name": "Pickup"}`,
+		"GET /api/server/v1/applications/src-app/inbound-protocols/oidc": `{"clientId": "src-client-id", "grantTypes": ["client_credentials"]}`,
+		"GET /api/server/v1/applications/src-app/authorized-apis":        `[]`,
+	})
+	target, targetRequests := newTestSDKClient(t, map[string]string{
+		"POST /api/server/v1/applications":                                  "",
+		"GET /api/server/v1/applications/cloned-app":                        `{"id": "cloned-app", "name": "Pickup Staging"}`,
+		"GET /api/server/v1/applications/cloned-app/inbound-protocols/oidc": `{"clientId": "new-client-id"}`,
+	})
+
+	result, err := source.CloneApplication(context.Background(), "src-app", "Pickup Staging", target, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cloned-app", result.Application.Id)
+	assert.Equal(t, "new-client-id", result.Application.ClientId)
+
+	// The source tenant is only read, and the application is created in the target tenant
+	assert.Zero(t, sourceRequests["POST /api/server/v1/applications"])
+	assert.Equal(t, 1, targetRequests["POST /api/server/v1/applications"])
+}
+
+func TestCloneApplicationWithinTenant(t *testing.T) {
+	client, requests := newTestSDKClient(t, map[string]string{
+		"GET /api/server/v1/applications/src-app":                           `{"id": "src-app", "name": "Pickup"}`,
+		"GET /api/server/v1/applications/src-app/inbound-protocols/oidc":    `{"clientId": "src-client-id"}`,
+		"GET /api/server/v1/applications/src-app/authorized-apis":           `[]`,
+		"POST /api/server/v1/applications":                                  "",
+		"GET /api/server/v1/applications/cloned-app":                        `{"id": "cloned-app", "name": "Pickup Copy"}`,
+		"GET /api/server/v1/applications/cloned-app/inbound-protocols/oidc": `{"clientId": "new-client-id"}`,
+	})
+
+	result, err := client.CloneApplication(context.Background(), "src-app", "Pickup Copy", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Pickup Copy", result.Application.Name)
+	assert.Equal(t, 1, requests["POST /api/server/v1/applications"])
+}
+
+func TestReplaceAPIResourceScopesWarnsAboutAuthorizedScopes(t *testing.T) {
+	client, requests := newTestSDKClient(t, map[string]string{
+		"GET /api/server/v1/api-resources/orders-api/scopes": `[{"id": "1", "name": "read_orders", "displayName": "Read orders"},
+			{"id": "2", "name": "export_orders", "displayName": "Export orders"}]`,
+		"GET /api/server/v1/api-resources/orders-api": `{"id": "orders-api", "name": "Orders", "identifier": "https://orders.example.com", "self": "",
+			"subscribedApplications": [{"id": "portal-app", "name": "Portal"}]}`,
+		"GET /api/server/v1/applications/portal-app/authorized-apis": `[
+			{"id": "other-api", "authorizedScopes": [{"name": "read_orders"}]},
+			{"id": "orders-api", "authorizedScopes": [{"name": "export_orders"}]}]`,
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/read_orders":   "",
+		"DELETE /api/server/v1/api-resources/orders-api/scopes/export_orders": "",
+	})
+
+	result, err := client.ReplaceAPIResourceScopes(context.Background(), "orders-api", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"export_orders", "read_orders"}, result.Removed)
+	assert.Equal(t, []string{"removed scope 'export_orders' is still authorized to application 'Portal'"}, result.Warnings)
+	assert.Equal(t, 1, requests["GET /api/server/v1/applications/portal-app/authorized-apis"])
+}